@@ -0,0 +1,139 @@
+package dropboxsign
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// AccountResponse contains the details of a Dropbox Sign account.
+type AccountResponse struct {
+	// AccountID is the unique identifier of the account
+	AccountID string `json:"account_id"`
+	// EmailAddress is the email address associated with the account
+	EmailAddress *string `json:"email_address,omitempty"`
+	// IsLocked indicates whether the account is locked
+	IsLocked bool `json:"is_locked"`
+	// IsPaidHS indicates whether the account has a paid Dropbox Sign subscription
+	IsPaidHS bool `json:"is_paid_hs"`
+	// IsPaidHF indicates whether the account has a paid Dropbox Fax subscription
+	IsPaidHF bool `json:"is_paid_hf"`
+	// Quotas contains the remaining quotas of the account
+	Quotas *AccountResponseQuotas `json:"quotas,omitempty"`
+	// CallbackURL is the URL that receives account callback events
+	CallbackURL *string `json:"callback_url,omitempty"`
+	// RoleCode is the account's membership role on its team
+	RoleCode *RoleCode `json:"role_code,omitempty"`
+	// TeamID is the ID of the team the account belongs to
+	TeamID *string `json:"team_id,omitempty"`
+	// Locale is the account's locale, used for emails and the signing page
+	Locale *string `json:"locale,omitempty"`
+}
+
+// IsAdmin reports whether the account is an administrator of its team.
+func (a *AccountResponse) IsAdmin() bool {
+	return a.RoleCode != nil && *a.RoleCode == RoleCodeAdmin
+}
+
+// AccountResponseQuotas contains the remaining quotas of an account.
+//
+// A nil value means the quota is unlimited.
+type AccountResponseQuotas struct {
+	// APISignatureRequestsLeft is the number of API signature requests remaining
+	APISignatureRequestsLeft *int `json:"api_signature_requests_left,omitempty"`
+	// DocumentsLeft is the number of signature requests remaining
+	DocumentsLeft *int `json:"documents_left,omitempty"`
+	// TemplatesTotal is the total number of templates allowed
+	TemplatesTotal *int `json:"templates_total,omitempty"`
+	// TemplatesLeft is the number of templates remaining
+	TemplatesLeft *int `json:"templates_left,omitempty"`
+	// SMSVerificationsLeft is the number of SMS verifications remaining
+	SMSVerificationsLeft *int `json:"sms_verifications_left,omitempty"`
+}
+
+// UpdateAccountRequest represents a request to update account settings.
+//
+// Only the fields that are set are sent, so settings the caller leaves unset
+// keep their current values.
+//
+// Example:
+//
+//	request := dropboxsign.NewUpdateAccountRequest().WithLocale("fr-FR")
+type UpdateAccountRequest struct {
+	// AccountID is the ID of the team member account to update; the account
+	// that owns the API key is updated when it is unset
+	AccountID *string `json:"account_id,omitempty"`
+	// CallbackURL is the URL that receives account callback events
+	CallbackURL *string `json:"callback_url,omitempty"`
+	// Locale is the account's locale, used for emails and the signing page
+	Locale *string `json:"locale,omitempty"`
+}
+
+// NewUpdateAccountRequest creates an empty account update request.
+func NewUpdateAccountRequest() *UpdateAccountRequest {
+	return &UpdateAccountRequest{}
+}
+
+// WithAccountID sets the ID of the team member account to update.
+func (u *UpdateAccountRequest) WithAccountID(accountID string) *UpdateAccountRequest {
+	u.AccountID = &accountID
+	return u
+}
+
+// WithCallbackURL sets the account callback URL. Pass an empty string to
+// remove the current callback URL.
+func (u *UpdateAccountRequest) WithCallbackURL(callbackURL string) *UpdateAccountRequest {
+	u.CallbackURL = &callbackURL
+	return u
+}
+
+// WithLocale sets the account locale, such as "en-US".
+func (u *UpdateAccountRequest) WithLocale(locale string) *UpdateAccountRequest {
+	u.Locale = &locale
+	return u
+}
+
+// RoleCode represents the membership role of an account on its team.
+type RoleCode string
+
+const (
+	// RoleCodeAdmin indicates a team administrator
+	RoleCodeAdmin RoleCode = "a"
+	// RoleCodeMember indicates a regular team member
+	RoleCodeMember RoleCode = "m"
+	// RoleCodeDeveloper indicates a team member with developer access
+	RoleCodeDeveloper RoleCode = "d"
+	// RoleCodeTeamManager indicates a team member who manages other members
+	RoleCodeTeamManager RoleCode = "t"
+	// RoleCodeUnknown indicates a role code not known to this package, such
+	// as one added to the API after this version
+	RoleCodeUnknown RoleCode = "unknown"
+)
+
+// UnmarshalJSON implements custom unmarshaling for RoleCode.
+func (r *RoleCode) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	*r = ParseRoleCode(str)
+	return nil
+}
+
+// ParseRoleCode parses a string into a RoleCode.
+//
+// Both the single-letter codes returned by the API and the role names are
+// accepted. Unrecognized values return RoleCodeUnknown.
+func ParseRoleCode(s string) RoleCode {
+	switch strings.TrimSpace(strings.ToLower(s)) {
+	case "a", "admin":
+		return RoleCodeAdmin
+	case "m", "member":
+		return RoleCodeMember
+	case "d", "developer":
+		return RoleCodeDeveloper
+	case "t", "team_manager":
+		return RoleCodeTeamManager
+	default:
+		return RoleCodeUnknown
+	}
+}
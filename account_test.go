@@ -0,0 +1,178 @@
+package dropboxsign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/account" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"account": {"account_id": "acct-1", "email_address": "admin@example.com", "role_code": "a", "quotas": {"documents_left": 5, "api_signature_requests_left": null}}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	account, _, err := client.GetAccount(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if account.AccountID != "acct-1" {
+		t.Errorf("expected account_id acct-1, got %s", account.AccountID)
+	}
+	if !account.IsAdmin() {
+		t.Errorf("expected admin role, got %v", account.RoleCode)
+	}
+	if account.Quotas == nil || account.Quotas.DocumentsLeft == nil || *account.Quotas.DocumentsLeft != 5 {
+		t.Errorf("expected 5 documents left, got %+v", account.Quotas)
+	}
+	if account.Quotas.APISignatureRequestsLeft != nil {
+		t.Error("expected unlimited API signature requests")
+	}
+}
+
+func TestPing(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		check      func(error) bool
+	}{
+		{name: "ok", statusCode: http.StatusOK, body: `{"account": {"account_id": "acct-1"}}`, check: func(err error) bool { return err == nil }},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, body: `{"error": {"error_msg": "Unauthorized api key", "error_name": "unauthorized"}}`, check: IsUnauthorized},
+		{name: "rate limited", statusCode: http.StatusTooManyRequests, body: `{"error": {"error_msg": "Rate limit exceeded", "error_name": "exceeded_rate"}}`, check: IsRateLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/account" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				if _, err := w.Write([]byte(tt.body)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+			if err := client.Ping(context.Background()); !tt.check(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+		err := client.Ping(context.Background())
+		var clientErr *ClientError
+		if !errors.As(err, &clientErr) || clientErr.StatusCode != 0 {
+			t.Errorf("expected a network client error, got %v", err)
+		}
+	})
+}
+
+func TestParseRoleCode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected RoleCode
+	}{
+		{"a", RoleCodeAdmin},
+		{"Admin", RoleCodeAdmin},
+		{"m", RoleCodeMember},
+		{"d", RoleCodeDeveloper},
+		{"t", RoleCodeTeamManager},
+		{" M ", RoleCodeMember},
+		{"x", RoleCodeUnknown},
+		{"", RoleCodeUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := ParseRoleCode(tt.input); got != tt.expected {
+			t.Errorf("ParseRoleCode(%q): expected %s, got %s", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestUpdateAccount_OnlySendsSetFields(t *testing.T) {
+	var fields map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/account" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"account": {"account_id": "acct-1", "locale": "fr-FR", "callback_url": "https://example.com/callback"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	account, _, err := client.UpdateAccount(context.Background(), NewUpdateAccountRequest().WithLocale("fr-FR"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fields) != 1 || fields["locale"] != "fr-FR" {
+		t.Errorf("expected only locale to be sent, got %v", fields)
+	}
+	if account.CallbackURL == nil || *account.CallbackURL != "https://example.com/callback" {
+		t.Errorf("expected callback URL to be kept, got %v", account.CallbackURL)
+	}
+}
+
+func TestUpdateAccount_AccountID(t *testing.T) {
+	var fields map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"account": {"account_id": "acct-2"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithAccountID("acct-2")
+
+	request := NewUpdateAccountRequest().WithCallbackURL("")
+	if _, _, err := client.UpdateAccount(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fields["account_id"] != "acct-2" {
+		t.Errorf("expected client account ID to be sent, got %v", fields["account_id"])
+	}
+	if callbackURL, ok := fields["callback_url"]; !ok || callbackURL != "" {
+		t.Errorf("expected explicit empty callback URL to be sent, got %v", fields)
+	}
+	if request.AccountID != nil {
+		t.Error("expected caller's request to be left unmodified")
+	}
+}
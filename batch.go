@@ -0,0 +1,69 @@
+package dropboxsign
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultBatchConcurrency is the number of calls in flight for batch
+// operations that do not take a concurrency from the caller.
+const defaultBatchConcurrency = 4
+
+// runBatch calls fn for each of n items with at most concurrency calls in
+// flight, and returns the error from each call indexed by item.
+//
+// Once a call returns a rate limit error or ctx is done, no further items are
+// started; each item that was not started receives that error instead.
+func runBatch(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, n)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		stopErr  error
+		sem      = make(chan struct{}, concurrency)
+		stopping = func() error {
+			mu.Lock()
+			defer mu.Unlock()
+			return stopErr
+		}
+	)
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		if err := stopping(); err != nil {
+			<-sem
+			errs[i] = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := fn(ctx, i)
+			errs[i] = err
+			if IsRateLimited(err) {
+				mu.Lock()
+				if stopErr == nil {
+					stopErr = err
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	return errs
+}
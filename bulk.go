@@ -0,0 +1,290 @@
+package dropboxsign
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var (
+	// bulkCSVRequiredColumns are the columns every bulk send signer file must contain
+	bulkCSVRequiredColumns = []string{"name", "email_address"}
+	// bulkCSVOptionalColumns are the signer columns a bulk send signer file may contain
+	bulkCSVOptionalColumns = []string{"pin", "sms_phone_number"}
+)
+
+// ValidateBulkCSV checks that a bulk send signer file matches template before
+// it is uploaded.
+//
+// Each row of the file describes one signer. The header row must contain
+// name and email_address, may contain pin and sms_phone_number, and every
+// other column must be the name of one of the template's custom fields.
+// Headers are compared case-insensitively. Every row must have as many
+// columns as the header, since a short row shifts its values into the wrong
+// fields. All problems found are returned together as a single joined error.
+//
+// A signer file has one set of signer columns, so it can only fill a template
+// with a single signer role; templates with more than one signer role, and a
+// nil template, are rejected before the file is read.
+//
+// Example:
+//
+//	template, _, err := client.GetTemplate(ctx, "template_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := dropboxsign.ValidateBulkCSV(file, template); err != nil {
+//		log.Fatalf("invalid signer file: %v", err)
+//	}
+func ValidateBulkCSV(r io.Reader, template *TemplateResponse) error {
+	if template == nil {
+		return errors.New("template is required to validate a signer file")
+	}
+	if len(template.SignerRoles) > 1 {
+		return fmt.Errorf("template %s has %d signer roles, but a signer file can only fill a template with one", template.TemplateID, len(template.SignerRoles))
+	}
+
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return errors.New("signer file is empty")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read signer file header: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, name := range append(bulkCSVRequiredColumns, bulkCSVOptionalColumns...) {
+		known[name] = true
+	}
+	for _, field := range template.CustomFields {
+		known[strings.ToLower(field.Name)] = true
+	}
+
+	var errs []error
+	seen := make(map[string]bool, len(header))
+	for i, column := range header {
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		name := strings.ToLower(strings.TrimSpace(column))
+		if seen[name] {
+			errs = append(errs, fmt.Errorf("column %d %q is a duplicate", i+1, column))
+			continue
+		}
+		seen[name] = true
+
+		if !known[name] {
+			errs = append(errs, fmt.Errorf("column %d %q is not a signer column or a custom field of template %s", i+1, column, template.TemplateID))
+		}
+	}
+	for _, name := range bulkCSVRequiredColumns {
+		if !seen[name] {
+			errs = append(errs, fmt.Errorf("missing required column %q", name))
+		}
+	}
+
+	for {
+		_, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			errs = append(errs, err)
+			if !errors.Is(err, csv.ErrFieldCount) {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// BulkSendJobResponse contains information about a bulk send job.
+type BulkSendJobResponse struct {
+	// BulkSendJobID is the unique identifier of the bulk send job
+	BulkSendJobID string `json:"bulk_send_job_id"`
+	// Total is the number of signature requests the job will send
+	Total int `json:"total"`
+	// IsCreator indicates whether the requesting account created the job
+	IsCreator bool `json:"is_creator"`
+	// CreatedAt is the Unix timestamp when the job was created
+	CreatedAt int64 `json:"created_at"`
+}
+
+// BulkSendJobGetResponse is a page of a bulk send job and the signature
+// requests it has created so far.
+type BulkSendJobGetResponse struct {
+	// BulkSendJob contains information about the job
+	BulkSendJob BulkSendJobResponse `json:"bulk_send_job"`
+	// ListInfo contains pagination information for SignatureRequests
+	ListInfo ListInfo `json:"list_info"`
+	// SignatureRequests is the list of signature requests on this page
+	SignatureRequests []SignatureRequestResponse `json:"signature_requests"`
+}
+
+// BulkProgress summarizes how far a bulk send job has progressed.
+type BulkProgress struct {
+	// Total is the number of signature requests the job will send
+	Total int
+	// Queued is the number of signature requests not created yet
+	Queued int
+	// Sent is the number of signature requests created without error
+	Sent int
+	// Errored is the number of signature requests that failed
+	Errored int
+	// Percent is the share of Total that has been processed, sent or
+	// errored, from 0 to 100
+	Percent float64
+}
+
+// Progress summarizes the progress of the bulk send job.
+//
+// The number of signature requests created so far is taken from ListInfo, so
+// Queued and Percent are accurate for any page. Sent and Errored can only be
+// told apart for the signature requests in the response, so for a job with
+// more than one page use Client.GetBulkSendJobProgress, which reads every page.
+func (r *BulkSendJobGetResponse) Progress() BulkProgress {
+	created := len(r.SignatureRequests)
+	if r.ListInfo.NumResults != nil && *r.ListInfo.NumResults > created {
+		created = *r.ListInfo.NumResults
+	}
+
+	errored := 0
+	for _, sigRequest := range r.SignatureRequests {
+		if sigRequest.HasError {
+			errored++
+		}
+	}
+
+	progress := BulkProgress{
+		Total:   r.BulkSendJob.Total,
+		Queued:  max(r.BulkSendJob.Total-created, 0),
+		Sent:    created - errored,
+		Errored: errored,
+	}
+	if progress.Total > 0 {
+		progress.Percent = min(float64(created)/float64(progress.Total)*100, 100)
+	}
+	return progress
+}
+
+// BulkSendJobOptions represents optional parameters for getting a bulk send job.
+type BulkSendJobOptions struct {
+	// Page is the page number of signature requests to return (1-based)
+	Page *int
+	// PageSize is the number of signature requests to return per page (1-100)
+	PageSize *int
+}
+
+// NewBulkSendJobOptions creates empty bulk send job options.
+func NewBulkSendJobOptions() *BulkSendJobOptions {
+	return &BulkSendJobOptions{}
+}
+
+// WithPage sets the page number to return.
+func (o *BulkSendJobOptions) WithPage(page int) *BulkSendJobOptions {
+	o.Page = &page
+	return o
+}
+
+// WithPageSize sets the number of signature requests to return per page.
+func (o *BulkSendJobOptions) WithPageSize(pageSize int) *BulkSendJobOptions {
+	o.PageSize = &pageSize
+	return o
+}
+
+// values encodes the options as URL query parameters.
+func (o *BulkSendJobOptions) values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	if o.Page != nil {
+		values.Set("page", strconv.Itoa(*o.Page))
+	}
+	if o.PageSize != nil {
+		values.Set("page_size", strconv.Itoa(*o.PageSize))
+	}
+	return values
+}
+
+// GetBulkSendJob retrieves a bulk send job and a page of the signature
+// requests it has created.
+//
+// Returns the job data and any warnings, or an error if the request fails.
+//
+// Example:
+//
+//	job, _, err := client.GetBulkSendJob(ctx, "bulk_send_job_id", nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%.0f%% processed\n", job.Progress().Percent)
+func (c *Client) GetBulkSendJob(ctx context.Context, bulkSendJobID string, opts *BulkSendJobOptions) (*BulkSendJobGetResponse, []WarningResponse, error) {
+	path := "/bulk_send_job/" + bulkSendJobID
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	job, warnings, err := parseListResponse[BulkSendJobGetResponse](c.marshaler, body)
+	if err != nil {
+		return nil, nil, NewClientError("failed to parse response", statusCode, err)
+	}
+
+	return job, warnings, nil
+}
+
+// GetBulkSendJobProgress summarizes the progress of a bulk send job, reading
+// every page of its signature requests so that Sent and Errored cover the
+// whole job.
+//
+// Example:
+//
+//	progress, err := client.GetBulkSendJobProgress(ctx, "bulk_send_job_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%d sent, %d errored, %d queued\n", progress.Sent, progress.Errored, progress.Queued)
+func (c *Client) GetBulkSendJobProgress(ctx context.Context, bulkSendJobID string) (*BulkProgress, error) {
+	opts := NewBulkSendJobOptions().WithPageSize(100)
+
+	var all *BulkSendJobGetResponse
+	for page := 1; ; {
+		job, _, err := c.GetBulkSendJob(ctx, bulkSendJobID, opts.WithPage(page))
+		if err != nil {
+			return nil, err
+		}
+
+		if all == nil {
+			all = job
+		} else {
+			all.SignatureRequests = append(all.SignatureRequests, job.SignatureRequests...)
+		}
+
+		if !job.ListInfo.HasNextPage() {
+			break
+		}
+		page = job.ListInfo.NextPage()
+	}
+
+	progress := all.Progress()
+	return &progress, nil
+}
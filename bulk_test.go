@@ -0,0 +1,190 @@
+package dropboxsign
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateBulkCSV(t *testing.T) {
+	template := &TemplateResponse{
+		TemplateID:  "tmpl-1",
+		SignerRoles: []TemplateResponseSignerRole{{Name: "Signer"}},
+		CustomFields: []TemplateResponseCustomField{
+			{Name: "Company", Type: "text"},
+			{Name: "Start Date", Type: "date"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr []string
+	}{
+		{
+			name: "valid",
+			csv:  "name,email_address,pin,company,Start Date\nJane Doe,jane@example.com,1234,Acme,2024-01-01\n",
+		},
+		{
+			name: "byte order mark",
+			csv:  "\ufeffName,Email_Address\nJane Doe,jane@example.com\n",
+		},
+		{
+			name:    "empty",
+			csv:     "",
+			wantErr: []string{"signer file is empty"},
+		},
+		{
+			name: "unknown and missing columns",
+			csv:  "name,email,Company\nJane Doe,jane@example.com,Acme\n",
+			wantErr: []string{
+				`column 2 "email" is not a signer column or a custom field of template tmpl-1`,
+				`missing required column "email_address"`,
+			},
+		},
+		{
+			name:    "duplicate column",
+			csv:     "name,email_address,company,Company\nJane Doe,jane@example.com,Acme,Acme\n",
+			wantErr: []string{`column 4 "Company" is a duplicate`},
+		},
+		{
+			name:    "short row",
+			csv:     "name,email_address,company\nJane Doe,jane@example.com,Acme\nJohn Doe,john@example.com\n",
+			wantErr: []string{"record on line 3: wrong number of fields"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBulkCSV(strings.NewReader(tt.csv), template)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error to contain %q, got %q", want, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateBulkCSV_InvalidTemplate(t *testing.T) {
+	csv := "name,email_address\nJane Doe,jane@example.com\n"
+
+	tests := []struct {
+		name     string
+		template *TemplateResponse
+		wantErr  string
+	}{
+		{
+			name:     "nil template",
+			template: nil,
+			wantErr:  "template is required to validate a signer file",
+		},
+		{
+			name: "several signer roles",
+			template: &TemplateResponse{
+				TemplateID:  "tmpl-1",
+				SignerRoles: []TemplateResponseSignerRole{{Name: "Client"}, {Name: "Witness"}},
+			},
+			wantErr: "template tmpl-1 has 2 signer roles",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBulkCSV(strings.NewReader(csv), tt.template)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBulkSendJobGetResponse_Progress(t *testing.T) {
+	numResults := 3
+	tests := []struct {
+		name     string
+		response BulkSendJobGetResponse
+		expected BulkProgress
+	}{
+		{
+			name:     "nothing created",
+			response: BulkSendJobGetResponse{BulkSendJob: BulkSendJobResponse{Total: 4}},
+			expected: BulkProgress{Total: 4, Queued: 4},
+		},
+		{
+			name: "partly created",
+			response: BulkSendJobGetResponse{
+				BulkSendJob:       BulkSendJobResponse{Total: 4},
+				SignatureRequests: []SignatureRequestResponse{{SignatureRequestID: "a"}, {SignatureRequestID: "b", HasError: true}},
+			},
+			expected: BulkProgress{Total: 4, Queued: 2, Sent: 1, Errored: 1, Percent: 50},
+		},
+		{
+			name: "created count from list info",
+			response: BulkSendJobGetResponse{
+				BulkSendJob:       BulkSendJobResponse{Total: 4},
+				ListInfo:          ListInfo{NumResults: &numResults, Page: 1, NumPages: 2, PageSize: 1},
+				SignatureRequests: []SignatureRequestResponse{{SignatureRequestID: "a"}},
+			},
+			expected: BulkProgress{Total: 4, Queued: 1, Sent: 3, Percent: 75},
+		},
+		{
+			name:     "empty job",
+			response: BulkSendJobGetResponse{},
+			expected: BulkProgress{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.response.Progress(); got != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetBulkSendJobProgress(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"bulk_send_job": {"bulk_send_job_id": "job-1", "total": 5}, "list_info": {"num_pages": 2, "num_results": 3, "page": 1, "page_size": 2}, "signature_requests": [{"signature_request_id": "a"}, {"signature_request_id": "b", "has_error": true}]}`,
+		"2": `{"bulk_send_job": {"bulk_send_job_id": "job-1", "total": 5}, "list_info": {"num_pages": 2, "num_results": 3, "page": 2, "page_size": 2}, "signature_requests": [{"signature_request_id": "c", "has_error": true}]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/bulk_send_job/job-1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if pageSize := r.URL.Query().Get("page_size"); pageSize != "100" {
+			t.Errorf("expected page_size 100, got %q", pageSize)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(pages[r.URL.Query().Get("page")])); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	progress, err := client.GetBulkSendJobProgress(context.Background(), "job-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := BulkProgress{Total: 5, Queued: 2, Sent: 1, Errored: 2, Percent: 60}
+	if *progress != expected {
+		t.Errorf("expected %+v, got %+v", expected, *progress)
+	}
+}
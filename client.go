@@ -5,17 +5,31 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 )
 
 const (
-	// APIBaseURL is the base URL for the Dropbox Sign API (v3)
-	APIBaseURL = "https://api.hellosign.com/v3"
+	// APIHost is the scheme and host of the Dropbox Sign API
+	APIHost = "https://api.hellosign.com"
+	// APIVersion is the default version of the Dropbox Sign API
+	APIVersion = "v3"
+	// APIBaseURL is the base URL for the Dropbox Sign API, including the version
+	APIBaseURL = APIHost + "/" + APIVersion
 	// DefaultTimeout is the default request timeout
 	DefaultTimeout = 30 * time.Second
+	// DefaultMaxErrorBodyLength is the default maximum number of bytes of an
+	// unparseable error response body included in error messages
+	DefaultMaxErrorBodyLength = 4096
 )
 
 // Client is an HTTP client for interacting with the Dropbox Sign API.
@@ -28,9 +42,34 @@ const (
 //	client := dropboxsign.NewClient("your-api-key").
 //		WithTimeout(60 * time.Second)
 type Client struct {
-	apiKey     string
-	httpClient *http.Client
-	baseURL    string
+	credentials CredentialProvider
+	password    string
+	httpClient  *http.Client
+	baseURL     string
+	apiVersion  string
+	marshaler   Marshaler
+
+	maxErrorBodyLength int
+	lastStatusCode     atomic.Int64
+	logger             Logger
+	retryLogging       bool
+	recorder           *recorder
+	dryRun             bool
+	accountID          string
+	testModeGuard      func(apiKey string) bool
+	forceTestMode      bool
+	fileURLPreflight   bool
+	validation         bool
+	requestGzip        bool
+	strictWarnings     []WarningName
+
+	maxNetworkRetries   int
+	networkRetryBackoff time.Duration
+	maxRetries          int
+	retryBaseDelay      time.Duration
+
+	templateCache          *templateCache
+	defaultDownloadOptions DownloadOptions
 }
 
 // NewClient creates a new Dropbox Sign client with the specified API key.
@@ -43,8 +82,15 @@ type Client struct {
 //	client := dropboxsign.NewClient("your-api-key")
 func NewClient(apiKey string) *Client {
 	return &Client{
-		apiKey:  apiKey,
-		baseURL: APIBaseURL,
+		credentials: StaticAPIKey(apiKey),
+		baseURL:     APIBaseURL,
+		apiVersion:  APIVersion,
+		marshaler:   jsonMarshaler{},
+
+		maxErrorBodyLength: DefaultMaxErrorBodyLength,
+
+		maxNetworkRetries:   DefaultMaxNetworkRetries,
+		networkRetryBackoff: defaultNetworkRetryBackoff,
 		httpClient: &http.Client{
 			Timeout: DefaultTimeout,
 			Transport: &http.Transport{
@@ -78,9 +124,43 @@ func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
 	return c
 }
 
+// WithDisableKeepAlives disables or re-enables reuse of connections between
+// requests.
+//
+// This is useful in serverless environments, where a process can be frozen
+// between invocations and a pooled connection may have been closed by the
+// server by the time it is reused. It applies to the client's HTTP transport
+// when that is an *http.Transport (including the default); other transports
+// are left unchanged. The transport and the HTTP client are copied, so a
+// client passed to WithHTTPClient is never modified.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").WithDisableKeepAlives(true)
+func (c *Client) WithDisableKeepAlives(disable bool) *Client {
+	transport := c.httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if t, ok := transport.(*http.Transport); ok {
+		t = t.Clone()
+		t.DisableKeepAlives = disable
+		// Copy the client too, since it may be one the caller owns, such as
+		// http.DefaultClient passed to WithHTTPClient.
+		httpClient := *c.httpClient
+		httpClient.Transport = t
+		c.httpClient = &httpClient
+	}
+	return c
+}
+
 // WithBaseURL sets a custom base URL for the API.
 //
-// This is primarily useful for testing against mock servers.
+// The URL must include the API version path segment (e.g. "/v3").
+// This is primarily useful for testing against mock servers; use WithRegion
+// to choose a Dropbox Sign endpoint. Whichever of the two is called last wins.
 //
 // Returns the client instance for method chaining.
 func (c *Client) WithBaseURL(baseURL string) *Client {
@@ -88,6 +168,201 @@ func (c *Client) WithBaseURL(baseURL string) *Client {
 	return c
 }
 
+// Region is the scheme and host of a regional Dropbox Sign API endpoint,
+// without the version path segment.
+type Region string
+
+// RegionUS is the default, global Dropbox Sign API endpoint.
+//
+// Dropbox Sign does not publish a separate API host for EU data residency;
+// accounts whose data is stored in the EU use this endpoint too unless
+// Dropbox Sign has given them another host, which can be used as
+// Region("https://...").
+const RegionUS Region = APIHost
+
+// WithRegion sets the API endpoint for the given region, adding the API
+// version (see WithAPIVersion) so the host does not need to be spelled out
+// with "/v3".
+//
+// WithRegion and WithBaseURL both set the base URL, so whichever is called
+// last wins.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").WithRegion(dropboxsign.RegionUS)
+func (c *Client) WithRegion(region Region) *Client {
+	c.baseURL = strings.TrimSuffix(string(region), "/") + "/" + c.apiVersion
+	return c
+}
+
+// WithBasicAuthPassword sets the password sent with the API key in the
+// basic auth header.
+//
+// Dropbox Sign uses the API key as the username with an empty password, which
+// remains the default. Set a password only when the base URL points at an
+// authenticating proxy or gateway that requires one.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").
+//		WithBaseURL("https://gateway.internal/v3").
+//		WithBasicAuthPassword(os.Getenv("GATEWAY_PASSWORD"))
+func (c *Client) WithBasicAuthPassword(password string) *Client {
+	c.password = password
+	return c
+}
+
+// WithAPIVersion sets the API version used for all requests.
+//
+// The version replaces the trailing version segment of the current base URL,
+// so it can be combined with WithBaseURL. The default is APIVersion.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").WithAPIVersion("v4")
+func (c *Client) WithAPIVersion(version string) *Client {
+	host := strings.TrimSuffix(c.baseURL, "/"+c.apiVersion)
+	c.baseURL = host + "/" + version
+	c.apiVersion = version
+	return c
+}
+
+// WithMarshaler sets the JSON implementation used to encode request bodies and
+// decode responses.
+//
+// The default uses encoding/json. A replacement must honor the `json` struct
+// tags and the json.Marshaler/json.Unmarshaler methods implemented by the
+// types in this package.
+//
+// Those UnmarshalJSON methods, such as the ones that accept custom field and
+// response data values of any JSON type, decode their own input with
+// encoding/json. Those parts of a response are decoded by encoding/json
+// whatever marshaler is set.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithMarshaler(m Marshaler) *Client {
+	c.marshaler = m
+	return c
+}
+
+// WithMaxErrorBodyLength sets the maximum number of bytes of an unparseable
+// error response body that is included in ClientError messages.
+//
+// Longer bodies are truncated with an ellipsis and a note of their original
+// length. A value of zero or less disables truncation. The default is
+// DefaultMaxErrorBodyLength.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithMaxErrorBodyLength(maxLength int) *Client {
+	c.maxErrorBodyLength = maxLength
+	return c
+}
+
+// WithAccountID sets the default team member account that API calls are
+// scoped to, for endpoints that accept an account ID.
+//
+// It can be overridden per call with the package-level WithAccountID context
+// helper, or per request where the options expose an AccountID.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithAccountID(accountID string) *Client {
+	c.accountID = accountID
+	return c
+}
+
+// WithDryRun enables or disables dry-run mode.
+//
+// In dry-run mode, requests that would change state (SendWithTemplate,
+// CreateEmbeddedWithTemplate, UpdateSignatureRequest, ExtendExpiration,
+// RemindSignatureRequest and its batch variants,
+// CancelIncompleteSignatureRequest, UpdateTemplateFiles, UpdateAccount and
+// CreateReport) are built, validated and reported to the logger, but never
+// sent. They return a synthetic success: methods that return a signature
+// request return one populated from the request, with an empty
+// SignatureRequestID. Read operations such as GetSignatureRequest and
+// ListSignatureRequests are still sent.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithDryRun(dryRun bool) *Client {
+	c.dryRun = dryRun
+	return c
+}
+
+// WithTestModeGuard sets a predicate that identifies test API keys.
+//
+// When the predicate returns true for the client's API key, every signature
+// request sent by the client is forced into test mode, and a request that
+// explicitly sets TestMode to false fails with an error instead of being
+// sent. This guards non-production environments against emailing real
+// signers. With a CredentialProvider, the predicate is given the current API
+// key each time a signature request is sent. Pass nil to remove the guard.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient(apiKey).WithTestModeGuard(func(apiKey string) bool {
+//		return strings.HasPrefix(apiKey, "staging_")
+//	})
+func (c *Client) WithTestModeGuard(predicate func(apiKey string) bool) *Client {
+	c.testModeGuard = predicate
+	return c
+}
+
+// WithTestModeFromEnv forces every signature request sent by the client into
+// test mode when the environment variable varName is set to a true value, as
+// accepted by strconv.ParseBool (such as "1" or "true").
+//
+// The variable is read once, when this method is called. Forced test mode
+// takes precedence over the request: a request that explicitly sets TestMode
+// to false fails with an error instead of being sent, exactly as with
+// WithTestModeGuard. When the variable is unset or false, requests are sent
+// as they are.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	// CI runs with DROPBOXSIGN_TEST_MODE=1
+//	client := dropboxsign.NewClient(apiKey).WithTestModeFromEnv("DROPBOXSIGN_TEST_MODE")
+func (c *Client) WithTestModeFromEnv(varName string) *Client {
+	c.forceTestMode, _ = strconv.ParseBool(os.Getenv(varName))
+	return c
+}
+
+// LastStatusCode returns the HTTP status code of the most recent API response
+// received by the client, or 0 if no response has been received yet.
+//
+// When the client is used concurrently, the value reflects whichever call
+// completed last; it is intended for tests and diagnostics.
+func (c *Client) LastStatusCode() int {
+	return int(c.lastStatusCode.Load())
+}
+
+// Close releases the resources held by the client: it closes the idle
+// connections of its HTTP client's transport and empties the template cache.
+//
+// Call Close when discarding a client, for example when replacing it after
+// rotating the API key or during a graceful shutdown. Requests in flight are
+// not interrupted, and a closed client remains usable; it opens new
+// connections as needed. Close always returns nil and satisfies io.Closer.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient(apiKey)
+//	defer client.Close()
+func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	c.templateCache.clear()
+	return nil
+}
+
 // GetSignatureRequest retrieves a signature request by its ID.
 //
 // Returns the signature request data and any warnings, or an error
@@ -102,36 +377,105 @@ func (c *Client) WithBaseURL(baseURL string) *Client {
 //	}
 //	fmt.Printf("Title: %s\n", sigRequest.Title)
 func (c *Client) GetSignatureRequest(ctx context.Context, signatureRequestID string) (*SignatureRequestResponse, []WarningResponse, error) {
-	url := fmt.Sprintf("%s/signature_request/%s", c.baseURL, signatureRequestID)
+	response, err := c.GetSignatureRequestWithResponse(ctx, signatureRequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &response.Inner, response.Warnings, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+// GetSignatureRequestWithResponse is GetSignatureRequest, also returning the
+// HTTP response so its headers (such as Date or X-Request-Id) can be inspected.
+//
+// Example:
+//
+//	response, err := client.GetSignatureRequestWithResponse(ctx, "signature_request_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	serverTime, _ := http.ParseTime(response.Response.Header.Get("Date"))
+//	fmt.Printf("%s at %v\n", response.Inner.Title, serverTime)
+func (c *Client) GetSignatureRequestWithResponse(ctx context.Context, signatureRequestID string) (*ResponseWithWarnings[SignatureRequestResponse], error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/signature_request/"+signatureRequestID, nil)
 	if err != nil {
-		return nil, nil, NewClientError("failed to create request", 0, err)
+		return nil, err
 	}
 
-	req.SetBasicAuth(c.apiKey, "")
+	body, resp, err := c.doResponse(req)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.httpClient.Do(req)
+	sigRequest, warnings, err := parseResponseWith[SignatureRequestResponse](c.marshaler, body, "signature_request")
 	if err != nil {
-		return nil, nil, NewClientError("failed to execute request", 0, err)
+		return nil, NewClientError("failed to parse response", resp.StatusCode, err)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	return &ResponseWithWarnings[SignatureRequestResponse]{Inner: *sigRequest, Warnings: warnings, Response: resp}, nil
+}
+
+// ListSignatureRequests retrieves a page of signature requests.
+//
+// Pass nil options to use the API defaults. Use the returned ListInfo
+// to page through results. Results are scoped to the account ID from the
+// options, the context or the client, in that order of precedence.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	opts := dropboxsign.NewListSignatureRequestsOptions().WithPageSize(100)
+//	for {
+//		list, _, err := client.ListSignatureRequests(ctx, opts)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		for _, sigRequest := range list.SignatureRequests {
+//			fmt.Println(sigRequest.Title)
+//		}
+//		if !list.ListInfo.HasNextPage() {
+//			break
+//		}
+//		opts.WithPage(list.ListInfo.NextPage())
+//	}
+func (c *Client) ListSignatureRequests(ctx context.Context, opts *ListSignatureRequestsOptions) (*SignatureRequestListResponse, []WarningResponse, error) {
+	response, err := c.ListSignatureRequestsWithResponse(ctx, opts)
 	if err != nil {
-		return nil, nil, NewClientError("failed to read response body", resp.StatusCode, err)
+		return nil, nil, err
+	}
+	return &response.Inner, response.Warnings, nil
+}
+
+// ListSignatureRequestsWithResponse is ListSignatureRequests, also returning
+// the HTTP response so its headers can be inspected.
+func (c *Client) ListSignatureRequestsWithResponse(ctx context.Context, opts *ListSignatureRequestsOptions) (*ResponseWithWarnings[SignatureRequestListResponse], error) {
+	values := opts.values()
+	if !values.Has("account_id") {
+		if accountID := c.accountIDFor(ctx); accountID != "" {
+			values.Set("account_id", accountID)
+		}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, nil, c.parseErrorResponse(body, resp.StatusCode)
+	path := "/signature_request/list"
+	if query := values.Encode(); query != "" {
+		path += "?" + query
 	}
 
-	sigRequest, warnings, err := parseResponse[SignatureRequestResponse](body, "signature_request")
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, nil, NewClientError("failed to parse response", resp.StatusCode, err)
+		return nil, err
 	}
 
-	return sigRequest, warnings, nil
+	body, resp, err := c.doResponse(req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, warnings, err := parseListResponse[SignatureRequestListResponse](c.marshaler, body)
+	if err != nil {
+		return nil, NewClientError("failed to parse response", resp.StatusCode, err)
+	}
+
+	return &ResponseWithWarnings[SignatureRequestListResponse]{Inner: *list, Warnings: warnings, Response: resp}, nil
 }
 
 // SendWithTemplate sends a signature request using a template.
@@ -162,50 +506,196 @@ func (c *Client) GetSignatureRequest(ctx context.Context, signatureRequestID str
 //	}
 //	fmt.Printf("Sent: %s\n", sigRequest.SignatureRequestID)
 func (c *Client) SendWithTemplate(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error) {
-	url := fmt.Sprintf("%s/signature_request/send_with_template", c.baseURL)
+	if err := c.validateForSend(request, true); err != nil {
+		return nil, nil, err
+	}
+	return c.postSignatureRequest(ctx, "/signature_request/send_with_template", request)
+}
+
+// SendWithTemplateWithResponse is SendWithTemplate, also returning the HTTP
+// response so its headers (such as X-Request-Id) can be inspected.
+//
+// In dry-run mode the Response field is nil, since nothing is sent.
+func (c *Client) SendWithTemplateWithResponse(ctx context.Context, request *SendSignatureRequest) (*ResponseWithWarnings[SignatureRequestResponse], error) {
+	if err := c.validateForSend(request, true); err != nil {
+		return nil, err
+	}
+	return c.postSignatureRequestWithResponse(ctx, "/signature_request/send_with_template", request)
+}
+
+// Send sends a signature request for documents given as Files or FileURLs
+// rather than a template.
+//
+// Requests with Files are uploaded as multipart/form-data, with each file
+// sent as a part with a content type detected from its contents; requests
+// with FileURLs are sent as JSON. Exactly one of Files and FileURLs must be
+// set, and TemplateIDs must be empty: use SendWithTemplate for templates.
+//
+// Returns the created signature request data and any warnings, or an error
+// if the request fails.
+//
+// Example:
+//
+//	signer := dropboxsign.NewSubSignatureRequestTemplateSigner("", "Jane Doe", "jane@example.com")
+//	request := dropboxsign.NewSendSignatureRequestWithFiles(
+//		[]dropboxsign.SubSignatureRequestTemplateSigner{signer},
+//		[][]byte{contractPDF},
+//	).WithTitle("Contract").WithTestMode(true)
+//
+//	sigRequest, _, err := client.Send(ctx, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) Send(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error) {
+	if len(request.TemplateIDs) > 0 {
+		return nil, nil, NewClientError("template_ids cannot be sent with Send; use SendWithTemplate", 0, nil)
+	}
+	if len(request.Files) == 0 && len(request.FileURLs) == 0 {
+		return nil, nil, NewClientError("files or file_urls is required", 0, nil)
+	}
+	if err := c.validateForSend(request, false); err != nil {
+		return nil, nil, err
+	}
+	return c.postSignatureRequest(ctx, "/signature_request/send", request)
+}
+
+// CreateEmbeddedWithTemplate creates a signature request for embedded signing
+// using a template.
+//
+// No emails are sent to signers, and the response carries no SigningURL.
+// Instead, fetch a signing URL for each of the response's
+// EmbeddedSignatureIDs with GetEmbeddedSignURL and load it in your
+// application. The request must set a ClientID, and the response has
+// IsEmbedded set.
+//
+// The API has no "sign now" option for signature requests (skip_me_now is
+// only accepted by unclaimed drafts). To have the requester sign first, add
+// them as the first signer and open their signing URL straight after
+// creating the request.
+//
+// The API has no privacy options for embedded signing: signers' names and
+// email addresses cannot be hidden from one another, and they appear in the
+// audit trail of the signed files. When parties must not see each other's
+// contact details, give each signer an address your application relays
+// instead of their own.
+//
+// Returns the created signature request data and any warnings, or an error
+// if the request fails.
+//
+// Example:
+//
+//	request := dropboxsign.NewSendSignatureRequest(signers, []string{"template-id"}).
+//		WithClientID("client-id")
+//	sigRequest, _, err := client.CreateEmbeddedWithTemplate(ctx, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) CreateEmbeddedWithTemplate(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error) {
+	if request.ClientID == nil || *request.ClientID == "" {
+		return nil, nil, NewClientError("client_id is required for embedded signature requests", 0, nil)
+	}
+	if err := c.validateForSend(request, true); err != nil {
+		return nil, nil, err
+	}
 
-	jsonData, err := json.Marshal(request)
+	sigRequest, warnings, err := c.postSignatureRequest(ctx, "/signature_request/create_embedded_with_template", request)
 	if err != nil {
-		return nil, nil, NewClientError("failed to marshal request", 0, err)
+		return nil, nil, err
 	}
+	sigRequest.IsEmbedded = true
+	return sigRequest, warnings, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+// CreateEmbeddedWithTemplateAndURLs creates an embedded signature request
+// using a template and then fetches the signing URL of every signature
+// concurrently.
+//
+// The returned map is keyed by signature ID. If some signing URLs cannot be
+// fetched, the created signature request and the URLs that were fetched are
+// returned together with an error describing the failures, so the request is
+// never lost. In dry-run mode the synthetic signatures have no signature IDs,
+// so no signing URLs are fetched and the returned map is empty.
+//
+// Example:
+//
+//	sigRequest, signURLs, _, err := client.CreateEmbeddedWithTemplateAndURLs(ctx, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	signURL := signURLs[sigRequest.Signatures[0].SignatureID]
+func (c *Client) CreateEmbeddedWithTemplateAndURLs(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, map[string]string, []WarningResponse, error) {
+	sigRequest, warnings, err := c.CreateEmbeddedWithTemplate(ctx, request)
 	if err != nil {
-		return nil, nil, NewClientError("failed to create request", 0, err)
+		return nil, nil, nil, err
+	}
+	if c.dryRun {
+		return sigRequest, map[string]string{}, warnings, nil
 	}
 
-	req.SetBasicAuth(c.apiKey, "")
-	req.Header.Set("Content-Type", "application/json")
+	signURLs := make([]string, len(sigRequest.Signatures))
+	errs := runBatch(ctx, len(sigRequest.Signatures), len(sigRequest.Signatures), func(ctx context.Context, i int) error {
+		embedded, _, err := c.GetEmbeddedSignURL(ctx, sigRequest.Signatures[i].SignatureID)
+		if err != nil {
+			return fmt.Errorf("get sign url for signature %s: %w", sigRequest.Signatures[i].SignatureID, err)
+		}
+		signURLs[i] = embedded.SignURL
+		return nil
+	})
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, nil, NewClientError("failed to execute request", 0, err)
+	urls := make(map[string]string, len(signURLs))
+	for i, signURL := range signURLs {
+		if errs[i] == nil {
+			urls[sigRequest.Signatures[i].SignatureID] = signURL
+		}
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	return sigRequest, urls, warnings, errors.Join(errs...)
+}
+
+// GetEmbeddedSignURL retrieves the embedded signing URL for a signature.
+//
+// signatureID identifies one signer's signature, as found in the SignatureID
+// of each entry of SignatureRequestResponse.Signatures (or listed by
+// EmbeddedSignatureIDs). It is not the signature request ID: passing a
+// SignatureRequestID fails with a not found error. The URL is short-lived;
+// check ExpiresAtTime before handing it to the signer.
+//
+// Returns the signing URL data and any warnings, or an error if the request fails.
+//
+// Example:
+//
+//	embedded, _, err := client.GetEmbeddedSignURL(ctx, sigRequest.Signatures[0].SignatureID)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(embedded.SignURL)
+func (c *Client) GetEmbeddedSignURL(ctx context.Context, signatureID string) (*EmbeddedResponse, []WarningResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/embedded/sign_url/"+signatureID, nil)
 	if err != nil {
-		return nil, nil, NewClientError("failed to read response body", resp.StatusCode, err)
+		return nil, nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, nil, c.parseErrorResponse(body, resp.StatusCode)
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	sigRequest, warnings, err := parseResponse[SignatureRequestResponse](body, "signature_request")
+	embedded, warnings, err := parseResponseWith[EmbeddedResponse](c.marshaler, body, "embedded")
 	if err != nil {
-		return nil, nil, NewClientError("failed to parse response", resp.StatusCode, err)
+		return nil, nil, NewClientError("failed to parse response", statusCode, err)
 	}
 
-	return sigRequest, warnings, nil
+	return embedded, warnings, nil
 }
 
 // CancelIncompleteSignatureRequest cancels an incomplete signature request.
 //
 // This can only be used on signature requests that have not been completed
-// by all signers.
+// by all signers. Access to the canceled request is kept; to permanently
+// remove access to a request and its files, use RemoveSignatureRequestAccess.
 //
 // Returns an error if the request fails or the signature request cannot be cancelled.
+// A reason attached to ctx with WithCancelReason is reported to the logger.
 //
 // Example:
 //
@@ -215,74 +705,1136 @@ func (c *Client) SendWithTemplate(ctx context.Context, request *SendSignatureReq
 //		log.Fatal(err)
 //	}
 func (c *Client) CancelIncompleteSignatureRequest(ctx context.Context, signatureRequestID string) error {
-	url := fmt.Sprintf("%s/signature_request/cancel/%s", c.baseURL, signatureRequestID)
+	if reason := cancelReasonFor(ctx); reason != "" {
+		ctx = context.WithValue(ctx, loggedCancelReasonContextKey, reason)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	req, err := c.newRequest(ctx, http.MethodPost, "/signature_request/cancel/"+signatureRequestID, nil)
 	if err != nil {
-		return NewClientError("failed to create request", 0, err)
+		return err
 	}
 
-	req.SetBasicAuth(c.apiKey, "")
+	return c.doNoBody(req)
+}
 
-	resp, err := c.httpClient.Do(req)
+// RemoveSignatureRequestAccess permanently removes your access to a signature
+// request and its files.
+//
+// This is irreversible and is not the same as canceling: cancellation stops
+// an incomplete request that signers are still working on, while removal
+// takes away your account's access to a finished request, including the
+// signed documents. The API only accepts requests that every signer has
+// signed or declined. Once removed, the request can no longer be retrieved
+// or downloaded, so download and store any files you need first; the other
+// parties keep their access. Use CancelIncompleteSignatureRequest to stop a
+// request that should not be signed.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	if _, err := client.DownloadFilesTo(ctx, "signature_request_id", archive, opts); err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := client.RemoveSignatureRequestAccess(ctx, "signature_request_id"); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) RemoveSignatureRequestAccess(ctx context.Context, signatureRequestID string) error {
+	req, err := c.newRequest(ctx, http.MethodPost, "/signature_request/remove/"+signatureRequestID, nil)
 	if err != nil {
-		return NewClientError("failed to execute request", 0, err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return NewClientError("failed to read error response body", resp.StatusCode, err)
-		}
-		return c.parseErrorResponse(body, resp.StatusCode)
+		return err
 	}
 
-	return nil
+	return c.doNoBody(req)
 }
 
-// parseResponse parses a JSON response from the Dropbox Sign API, extracting the main payload and any warnings.
+// CancelByMetadata cancels every incomplete signature request whose metadata
+// has the given key and value, such as all requests tagged with a customer ID
+// when that customer offboards.
 //
-// This utility function handles the common pattern of Dropbox Sign API responses which
-// contain the main data under a specific key (e.g., "signature_request") and optional
-// warnings at the top level.
-func parseResponse[T any](body []byte, key string) (*T, []WarningResponse, error) {
-	var rawResponse map[string]json.RawMessage
-	if err := json.Unmarshal(body, &rawResponse); err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
+// The requests are found with a ListSignatureRequests metadata query (see
+// QueryBuilder.Metadata), which the API filters on the server, and every page
+// of results is read before anything is canceled. Results are checked for an
+// exact metadata match, and complete and declined requests are skipped. It
+// returns the IDs of the requests that were canceled and an entry for each
+// request that could not be, keyed by signature request ID. If listing
+// fails, nothing is canceled and the list error is returned. If the API
+// responds with a rate limit error, no further cancellations are started and
+// the remaining IDs are reported with that error.
+//
+// Example:
+//
+//	canceled, failed, err := client.CancelByMetadata(ctx, "customer_id", "cus_123")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for signatureRequestID, err := range failed {
+//		log.Printf("cancel %s: %v", signatureRequestID, err)
+//	}
+//	log.Printf("canceled %d signature requests", len(canceled))
+func (c *Client) CancelByMetadata(ctx context.Context, key, value string) ([]string, map[string]error, error) {
+	var matched []string
+	query := NewQueryBuilder().Metadata(key, value).String()
+	err := c.eachSignatureRequest(ctx, query, func(sigRequest *SignatureRequestResponse) {
+		if sigRequest.IsComplete || sigRequest.IsDeclined {
+			return
+		}
+		if v, ok := sigRequest.Metadata[key]; ok && v == value {
+			matched = append(matched, sigRequest.SignatureRequestID)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Extract main payload by key
-	payload, ok := rawResponse[key]
-	if !ok {
-		return nil, nil, fmt.Errorf("missing key '%s' in response", key)
-	}
+	errs := runBatch(ctx, len(matched), defaultBatchConcurrency, func(ctx context.Context, i int) error {
+		return c.CancelIncompleteSignatureRequest(ctx, matched[i])
+	})
 
-	// Deserialize the payload into T
-	var result T
-	if err := json.Unmarshal(payload, &result); err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+	canceled := make([]string, 0, len(matched))
+	failed := make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			failed[matched[i]] = err
+			continue
+		}
+		canceled = append(canceled, matched[i])
 	}
+	return canceled, failed, nil
+}
 
-	// Extract warnings if present
-	var warnings []WarningResponse
-	if warningsData, ok := rawResponse["warnings"]; ok {
-		if err := json.Unmarshal(warningsData, &warnings); err != nil {
-			// Non-fatal: we can continue without warnings
-			warnings = nil
+// CustomIDMetadataKey is the metadata key under which EnsureSent records the
+// custom ID of the signature requests it sends.
+const CustomIDMetadataKey = "custom_id"
+
+// GetSignatureRequestByCustomID retrieves the signature request whose
+// metadata has customID under CustomIDMetadataKey, as set by EnsureSent.
+//
+// The API has no custom ID search, so the requests are found with a
+// ListSignatureRequests metadata query (see QueryBuilder.Metadata), which the
+// API filters on the server; the results are then checked for an exact match.
+// Prefer GetSignatureRequest when the signature request ID is known. It
+// returns an error for which IsNotFound is true if no request matches, and
+// an error listing the matching IDs if more than one does.
+//
+// Example:
+//
+//	sigRequest, err := client.GetSignatureRequestByCustomID(ctx, "order-1234")
+//	if dropboxsign.IsNotFound(err) {
+//		// no signature request for this order
+//	}
+func (c *Client) GetSignatureRequestByCustomID(ctx context.Context, customID string) (*SignatureRequestResponse, error) {
+	var matched []SignatureRequestResponse
+	query := NewQueryBuilder().Metadata(CustomIDMetadataKey, customID).String()
+	err := c.eachSignatureRequest(ctx, query, func(sigRequest *SignatureRequestResponse) {
+		if sigRequest.Metadata[CustomIDMetadataKey] == customID {
+			matched = append(matched, *sigRequest)
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &result, warnings, nil
+	switch len(matched) {
+	case 0:
+		return nil, NewClientError(fmt.Sprintf("no signature request has custom ID %q", customID), http.StatusNotFound, nil)
+	case 1:
+		return &matched[0], nil
+	default:
+		ids := make([]string, len(matched))
+		for i, sigRequest := range matched {
+			ids[i] = sigRequest.SignatureRequestID
+		}
+		return nil, NewClientError(fmt.Sprintf("%d signature requests have custom ID %q: %s", len(matched), customID, strings.Join(ids, ", ")), 0, nil)
+	}
 }
 
-// parseErrorResponse parses an error response from the Dropbox Sign API.
-func (c *Client) parseErrorResponse(body []byte, statusCode int) error {
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return NewClientError(fmt.Sprintf("failed to parse error response: %s", string(body)), statusCode, err)
+// EnsureSent sends request unless a signature request with customID has
+// already been sent, in which case that request is returned instead, so a
+// send retried after a crash or timeout does not email the signers twice.
+// Requests with TemplateIDs are sent with SendWithTemplate, and requests for
+// uploaded documents with Send.
+//
+// The API does not let senders set custom IDs, so the request is sent with
+// customID in its metadata under CustomIDMetadataKey, and existing requests
+// are found with GetSignatureRequestByCustomID. The lookup and the send are
+// separate API calls: callers that may send the same customID concurrently
+// must serialize them, for example with a lock held on customID. Warnings are
+// only returned when the request is sent.
+//
+// Example:
+//
+//	sigRequest, _, err := client.EnsureSent(ctx, "order-1234", request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) EnsureSent(ctx context.Context, customID string, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error) {
+	if customID == "" {
+		return nil, nil, NewClientError("custom ID is required", 0, nil)
+	}
+
+	existing, err := c.GetSignatureRequestByCustomID(ctx, customID)
+	if err == nil {
+		return existing, nil, nil
+	}
+	if !IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	tagged := *request
+	tagged.Metadata = make(map[string]string, len(request.Metadata)+1)
+	for key, value := range request.Metadata {
+		tagged.Metadata[key] = value
+	}
+	tagged.Metadata[CustomIDMetadataKey] = customID
+
+	if len(tagged.TemplateIDs) == 0 {
+		return c.Send(ctx, &tagged)
+	}
+	return c.SendWithTemplate(ctx, &tagged)
+}
+
+// eachSignatureRequest calls fn for every signature request returned by
+// ListSignatureRequests for query, reading one page at a time. An empty
+// query lists every signature request.
+func (c *Client) eachSignatureRequest(ctx context.Context, query string, fn func(*SignatureRequestResponse)) error {
+	opts := NewListSignatureRequestsOptions().WithPageSize(100)
+	if query != "" {
+		opts.WithQuery(query)
+	}
+	for page := 1; ; {
+		list, _, err := c.ListSignatureRequests(ctx, opts.WithPage(page))
+		if err != nil {
+			return err
+		}
+
+		for i := range list.SignatureRequests {
+			fn(&list.SignatureRequests[i])
+		}
+
+		if !list.ListInfo.HasNextPage() {
+			return nil
+		}
+		page = list.ListInfo.NextPage()
+	}
+}
+
+// ResendWithChanges replaces a signature request with a corrected copy.
+//
+// The request is rebuilt from original with NewSendSignatureRequestFromResponse,
+// passed to apply for changes (apply may be nil), and sent with
+// SendWithTemplate. Only once the new request has been sent is the original
+// cancelled, so a failed send leaves the original untouched. If the
+// cancellation fails, the new signature request is returned along with the
+// error, and the original must be cancelled separately.
+//
+// See NewSendSignatureRequestFromResponse for the settings that cannot be
+// carried over and must be restored in apply.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	resent, _, err := client.ResendWithChanges(ctx, sigRequest, func(r *dropboxsign.SendSignatureRequest) {
+//		r.WithMessage("Please sign the corrected contract.")
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) ResendWithChanges(ctx context.Context, original *SignatureRequestResponse, apply func(*SendSignatureRequest)) (*SignatureRequestResponse, []WarningResponse, error) {
+	request, err := NewSendSignatureRequestFromResponse(original)
+	if err != nil {
+		return nil, nil, NewClientError("failed to rebuild signature request", 0, err)
+	}
+	if apply != nil {
+		apply(request)
+	}
+
+	resent, warnings, err := c.SendWithTemplate(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.CancelIncompleteSignatureRequest(ctx, original.SignatureRequestID); err != nil {
+		return resent, warnings, fmt.Errorf("sent %s but failed to cancel %s: %w", resent.SignatureRequestID, original.SignatureRequestID, err)
+	}
+
+	return resent, warnings, nil
+}
+
+// RemindSignatureRequest sends an email reminder to a signer who has not yet
+// signed the signature request.
+//
+// Use WithRemindName to pick out the signer when several signers share
+// emailAddress. If the signer has already signed, the error is a
+// *SignerAlreadySignedError; check for it with IsSignerAlreadySigned.
+//
+// Returns the updated signature request data and any warnings, or an error
+// if the request fails.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	_, _, err := client.RemindSignatureRequest(ctx, "signature_request_id", "jane@example.com")
+//	if dropboxsign.IsSignerAlreadySigned(err) {
+//		// nothing left to remind
+//	} else if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) RemindSignatureRequest(ctx context.Context, signatureRequestID, emailAddress string, opts ...RemindOption) (*SignatureRequestResponse, []WarningResponse, error) {
+	options := NewRemindOptions(opts...)
+	sigRequest, warnings, err := c.postSignatureRequest(ctx, "/signature_request/remind/"+signatureRequestID, remindSignatureRequest{
+		EmailAddress: emailAddress,
+		Name:         options.Name,
+	})
+	if apiErr, ok := isAlreadySignedError(err); ok {
+		return nil, nil, &SignerAlreadySignedError{SignatureRequestID: signatureRequestID, EmailAddress: emailAddress, Err: apiErr}
+	}
+	return sigRequest, warnings, err
+}
+
+// RemindAllPending sends a reminder to every signer of the signature request
+// whose signature is still awaited.
+//
+// Reminders are sent one at a time. If the API responds with a rate limit
+// error, no further reminders are sent and the rate limit error is returned
+// so the caller can retry later. Other failures are collected and returned
+// together once every pending signer has been tried.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	if err := client.RemindAllPending(ctx, "signature_request_id"); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) RemindAllPending(ctx context.Context, signatureRequestID string) error {
+	sigRequest, _, err := c.GetSignatureRequest(ctx, signatureRequestID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, signature := range sigRequest.Signatures {
+		if ParseSignerStatus(signature.StatusCode) != SignerStatusAwaitingSignature {
+			continue
+		}
+
+		if _, _, err := c.RemindSignatureRequest(ctx, signatureRequestID, signature.SignerEmailAddress); err != nil {
+			if IsRateLimited(err) || ctx.Err() != nil {
+				return errors.Join(append(errs, err)...)
+			}
+			errs = append(errs, fmt.Errorf("remind %s: %w", signature.SignerEmailAddress, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ReminderTarget identifies a signer to remind on a signature request.
+type ReminderTarget struct {
+	// SignatureRequestID is the ID of the signature request
+	SignatureRequestID string
+	// Email is the email address of the signer to remind
+	Email string
+}
+
+// String returns the target as "signature_request_id/email", the key used
+// by RemindSignatureRequests to report errors.
+func (t ReminderTarget) String() string {
+	return t.SignatureRequestID + "/" + t.Email
+}
+
+// RemindSignatureRequests sends reminders for many signers, across any number
+// of signature requests, with at most concurrency reminders in flight.
+//
+// The returned map contains an entry for each target that failed, keyed by
+// ReminderTarget.String(); it is empty when every reminder succeeds. If the
+// API responds with a rate limit error, no further reminders are started and
+// the remaining targets are reported with that error so they can be retried later.
+//
+// Example:
+//
+//	targets := []dropboxsign.ReminderTarget{
+//		{SignatureRequestID: "id-1", Email: "jane@example.com"},
+//		{SignatureRequestID: "id-2", Email: "john@example.com"},
+//	}
+//	for target, err := range client.RemindSignatureRequests(ctx, targets, 4) {
+//		log.Printf("remind %s: %v", target, err)
+//	}
+func (c *Client) RemindSignatureRequests(ctx context.Context, reminders []ReminderTarget, concurrency int) map[string]error {
+	errs := runBatch(ctx, len(reminders), concurrency, func(ctx context.Context, i int) error {
+		_, _, err := c.RemindSignatureRequest(ctx, reminders[i].SignatureRequestID, reminders[i].Email)
+		return err
+	})
+
+	failed := make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			failed[reminders[i].String()] = err
+		}
+	}
+	return failed
+}
+
+// UpdateSignatureRequest updates a signer's email address or name, or the
+// expiration of a signature request, without canceling it.
+//
+// Returns the updated signature request data and any warnings, or an error
+// if the request fails.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	request := dropboxsign.NewUpdateSignatureRequest("signature_id").
+//		WithEmailAddress("new@example.com")
+//	sigRequest, _, err := client.UpdateSignatureRequest(ctx, "signature_request_id", request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) UpdateSignatureRequest(ctx context.Context, signatureRequestID string, request *UpdateSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error) {
+	return c.postSignatureRequest(ctx, "/signature_request/update/"+signatureRequestID, request)
+}
+
+// ExtendExpiration moves the expiration of a signature request to newExpiry
+// while keeping all signing progress.
+//
+// The new expiry must be in the future and, if the signature request already
+// has an expiration, later than it. Otherwise a ClientError is returned
+// without calling the update endpoint.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	newExpiry := time.Now().Add(7 * 24 * time.Hour)
+//	if err := client.ExtendExpiration(ctx, "signature_request_id", newExpiry); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) ExtendExpiration(ctx context.Context, signatureRequestID string, newExpiry time.Time) error {
+	if !newExpiry.After(time.Now()) {
+		return NewClientError(fmt.Sprintf("new expiry %s is not in the future", newExpiry.Format(time.RFC3339)), 0, nil)
+	}
+
+	sigRequest, _, err := c.GetSignatureRequest(ctx, signatureRequestID)
+	if err != nil {
+		return err
+	}
+
+	if sigRequest.ExpiresAt != nil {
+		current := time.Unix(*sigRequest.ExpiresAt, 0)
+		if !newExpiry.After(current) {
+			return NewClientError(fmt.Sprintf("new expiry %s is not after the current expiry %s", newExpiry.Format(time.RFC3339), current.Format(time.RFC3339)), 0, nil)
+		}
+	}
+
+	// The update endpoint requires a signature ID even when only the
+	// expiration changes; any signature of the request will do.
+	if len(sigRequest.Signatures) == 0 {
+		return NewClientError("signature request has no signatures to update", 0, nil)
+	}
+
+	request := NewUpdateSignatureRequest(sigRequest.Signatures[0].SignatureID).WithExpiresAt(newExpiry)
+	_, _, err = c.UpdateSignatureRequest(ctx, signatureRequestID, request)
+	return err
+}
+
+// GetTemplate retrieves a template by its ID.
+//
+// When the client has a template cache (see WithTemplateCache), a cached
+// template is returned without calling the API, with no warnings. The
+// returned template is a copy and may be modified.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	template, _, err := client.GetTemplate(ctx, "template_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, role := range template.SignerRoles {
+//		fmt.Println(role.Name)
+//	}
+func (c *Client) GetTemplate(ctx context.Context, templateID string) (*TemplateResponse, []WarningResponse, error) {
+	if template, ok := c.templateCache.get(templateID); ok {
+		return template, nil, nil
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, "/template/"+templateID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, warnings, err := parseResponseWith[TemplateResponse](c.marshaler, body, "template")
+	if err != nil {
+		return nil, nil, NewClientError("failed to parse response", statusCode, err)
+	}
+
+	c.templateCache.put(template)
+	return template, warnings, nil
+}
+
+// UpdateTemplateFiles replaces the documents of an existing template in place,
+// keeping the template ID and the positions of its fields.
+//
+// The new documents must have the same page layout as the originals, since
+// the template's fields are overlaid onto them at their existing positions.
+// The API processes the update asynchronously: a nil error means the files
+// were accepted, and completion is reported through the template_created or
+// template_error callback events.
+//
+// The files are named "file0.pdf", "file1.pdf" and so on, with the extension
+// derived from their detected content type; Word and other Office documents
+// cannot be detected and are sent without an extension. The files are
+// streamed to the API rather than buffered into a single request body. If
+// ctx is canceled mid-upload, the upload is aborted and the
+// returned error wraps context.Canceled.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	pdf, _ := os.ReadFile("contract-v2.pdf")
+//	if err := client.UpdateTemplateFiles(ctx, "template_id", [][]byte{pdf}); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) UpdateTemplateFiles(ctx context.Context, templateID string, files [][]byte) error {
+	if len(files) == 0 {
+		return NewClientError("at least one file is required", 0, nil)
+	}
+
+	body, contentType, err := c.multipartBody(ctx, func(w *multipart.Writer) error {
+		return writeFileParts(w, files, nil)
+	})
+	if err != nil {
+		return NewClientError("failed to build request body", 0, err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/template/update_files/"+templateID, body)
+	if err != nil {
+		if closer, ok := body.(io.Closer); ok {
+			closer.Close()
+		}
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	return c.doNoBody(req)
+}
+
+// GetAccount retrieves the account that owns the API key.
+//
+// Returns the account data and any warnings, or an error if the request fails.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	account, _, err := client.GetAccount(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if account.IsAdmin() {
+//		fmt.Println("team administrator")
+//	}
+func (c *Client) GetAccount(ctx context.Context) (*AccountResponse, []WarningResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/account", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, warnings, err := parseResponseWith[AccountResponse](c.marshaler, body, "account")
+	if err != nil {
+		return nil, nil, NewClientError("failed to parse response", statusCode, err)
+	}
+
+	return account, warnings, nil
+}
+
+// Ping checks that the API is reachable and the API key is valid, for
+// readiness probes and startup checks.
+//
+// It makes an authenticated GET /account request and returns nil if it
+// succeeds. Otherwise it returns the request's error, which can be classified
+// with IsUnauthorized (invalid or revoked API key), IsRateLimited, or
+// errors.As with *ClientError (network failure, StatusCode 0).
+//
+// Example:
+//
+//	if err := client.Ping(ctx); err != nil {
+//		if dropboxsign.IsUnauthorized(err) {
+//			log.Fatal("invalid Dropbox Sign API key")
+//		}
+//		log.Fatalf("Dropbox Sign unreachable: %v", err)
+//	}
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := c.newRequest(ctx, http.MethodGet, "/account", nil)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.do(req)
+	return err
+}
+
+// UpdateAccount updates the settings of an account.
+//
+// Only the fields set on request are changed. When the request has no
+// AccountID, the account ID from the context or client (see WithAccountID)
+// is used, if any.
+//
+// Returns the updated account data and any warnings, or an error if the
+// request fails.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	request := dropboxsign.NewUpdateAccountRequest().
+//		WithCallbackURL("https://example.com/dropbox-sign/callback")
+//	account, _, err := client.UpdateAccount(ctx, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) UpdateAccount(ctx context.Context, request *UpdateAccountRequest) (*AccountResponse, []WarningResponse, error) {
+	if request.AccountID == nil {
+		if accountID := c.accountIDFor(ctx); accountID != "" {
+			scoped := *request
+			scoped.AccountID = &accountID
+			request = &scoped
+		}
+	}
+
+	jsonData, err := c.marshaler.Marshal(request)
+	if err != nil {
+		return nil, nil, NewClientError("failed to marshal request", 0, err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/account", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.dryRun {
+		account := &AccountResponse{
+			CallbackURL: request.CallbackURL,
+			Locale:      request.Locale,
+		}
+		if request.AccountID != nil {
+			account.AccountID = *request.AccountID
+		}
+		return account, nil, nil
+	}
+
+	account, warnings, err := parseResponseWith[AccountResponse](c.marshaler, body, "account")
+	if err != nil {
+		return nil, nil, NewClientError("failed to parse response", statusCode, err)
+	}
+
+	return account, warnings, nil
+}
+
+// CreateReport requests one or more reports covering a date range.
+//
+// Reports are generated asynchronously and emailed to the account owner once
+// ready. The API does not expose report status or download links, so there is
+// no way to poll for completion; the returned ReportResponse only confirms
+// that the request was accepted.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	request := dropboxsign.NewCreateReportRequest(start, end, dropboxsign.ReportTypeDocumentStatus)
+//	report, _, err := client.CreateReport(ctx, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(report.Success)
+func (c *Client) CreateReport(ctx context.Context, request *CreateReportRequest) (*ReportResponse, []WarningResponse, error) {
+	jsonData, err := c.marshaler.Marshal(request)
+	if err != nil {
+		return nil, nil, NewClientError("failed to marshal request", 0, err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/report/create", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.dryRun {
+		return &ReportResponse{
+			StartDate:  request.StartDate,
+			EndDate:    request.EndDate,
+			ReportType: request.ReportType,
+		}, nil, nil
+	}
+
+	report, warnings, err := parseResponseWith[ReportResponse](c.marshaler, body, "report")
+	if err != nil {
+		return nil, nil, NewClientError("failed to parse response", statusCode, err)
+	}
+
+	return report, warnings, nil
+}
+
+// remindSignatureRequest is the request body for the remind endpoint.
+type remindSignatureRequest struct {
+	EmailAddress string  `json:"email_address"`
+	Name         *string `json:"name,omitempty"`
+}
+
+// postSignatureRequest posts a JSON payload to an endpoint that responds with
+// a signature request object.
+func (c *Client) postSignatureRequest(ctx context.Context, path string, payload any) (*SignatureRequestResponse, []WarningResponse, error) {
+	response, err := c.postSignatureRequestWithResponse(ctx, path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &response.Inner, response.Warnings, nil
+}
+
+// postSignatureRequestWithResponse is postSignatureRequest, also returning the
+// HTTP response.
+func (c *Client) postSignatureRequestWithResponse(ctx context.Context, path string, payload any) (*ResponseWithWarnings[SignatureRequestResponse], error) {
+	if request, ok := payload.(*SendSignatureRequest); ok {
+		if len(request.Files) > 0 && len(request.FileURLs) > 0 {
+			return nil, NewClientError("files and file_urls cannot both be set", 0, nil)
+		}
+		if len(request.FileNames) > len(request.Files) {
+			return nil, NewClientError("file_names has more entries than files", 0, nil)
+		}
+		guarded, err := c.applyTestModeGuard(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		normalized, err := guarded.normalized()
+		if err != nil {
+			return nil, NewClientError("invalid signature request", 0, err)
+		}
+		payload = normalized
+
+		if c.fileURLPreflight && len(request.FileURLs) > 0 {
+			if err := c.preflightFileURLs(ctx, request.FileURLs); err != nil {
+				return nil, NewClientError("file URL preflight failed", 0, err)
+			}
+		}
+	}
+
+	jsonData, err := c.marshaler.Marshal(payload)
+	if err != nil {
+		return nil, NewClientError("failed to marshal request", 0, err)
+	}
+
+	var (
+		reqBody     io.Reader = bytes.NewBuffer(jsonData)
+		contentType           = "application/json"
+	)
+	if request, ok := payload.(*SendSignatureRequest); ok && len(request.Files) > 0 {
+		// Files can only be uploaded as multipart/form-data, so the other
+		// fields are sent as form fields alongside them.
+		multipartBody, multipartContentType, err := c.multipartBody(ctx, func(w *multipart.Writer) error {
+			if err := writeFormFields(w, jsonData); err != nil {
+				return err
+			}
+			return writeFileParts(w, request.Files, request.FileNames)
+		})
+		if err != nil {
+			return nil, NewClientError("failed to build request body", 0, err)
+		}
+		if closer, ok := multipartBody.(io.Closer); ok {
+			defer closer.Close()
+		}
+		reqBody, contentType = multipartBody, multipartContentType
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	if c.dryRun {
+		// do never sends state-changing requests in dry-run mode, so validate
+		// the payload and answer with a response synthesized from it instead.
+		if request, ok := payload.(*SendSignatureRequest); ok {
+			if err := request.Validate(); err != nil {
+				return nil, NewClientError("invalid signature request", 0, err)
+			}
+		}
+		if _, _, err := c.do(req); err != nil {
+			return nil, err
+		}
+		return &ResponseWithWarnings[SignatureRequestResponse]{Inner: *dryRunSignatureRequest(payload)}, nil
+	}
+
+	body, resp, err := c.doResponse(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sigRequest, warnings, err := parseResponseWith[SignatureRequestResponse](c.marshaler, body, "signature_request")
+	if err != nil {
+		return nil, NewClientError("failed to parse response", resp.StatusCode, err)
+	}
+	if err := c.checkStrictWarnings(sigRequest, warnings); err != nil {
+		return nil, err
+	}
+
+	return &ResponseWithWarnings[SignatureRequestResponse]{Inner: *sigRequest, Warnings: warnings, Response: resp}, nil
+}
+
+// applyTestModeGuard returns request forced into test mode if test mode is
+// forced from the environment or the client's API key matches the test mode
+// guard. The caller's request is not modified.
+func (c *Client) applyTestModeGuard(ctx context.Context, request *SendSignatureRequest) (*SendSignatureRequest, error) {
+	if !c.forceTestMode {
+		if c.testModeGuard == nil {
+			return request, nil
+		}
+		apiKey, err := c.credentials.APIKey(ctx)
+		if err != nil {
+			return nil, NewClientError("failed to get API key", 0, err)
+		}
+		if !c.testModeGuard(apiKey) {
+			return request, nil
+		}
+	}
+	if request.TestMode != nil && !*request.TestMode {
+		if c.forceTestMode {
+			return nil, NewClientError("refusing to send a live signature request while test mode is forced", 0, nil)
+		}
+		return nil, NewClientError("refusing to send a live signature request with a test API key", 0, nil)
+	}
+
+	guarded := *request
+	testMode := true
+	guarded.TestMode = &testMode
+	return &guarded, nil
+}
+
+// dryRunSignatureRequest builds the synthetic response returned in dry-run mode.
+func dryRunSignatureRequest(payload any) *SignatureRequestResponse {
+	sigRequest := &SignatureRequestResponse{}
+
+	request, ok := payload.(*SendSignatureRequest)
+	if !ok {
+		return sigRequest
+	}
+
+	sigRequest.TestMode = request.TestMode
+	sigRequest.Message = request.Message
+	sigRequest.Subject = request.Subject
+	sigRequest.Metadata = request.Metadata
+	sigRequest.TemplateIDs = request.TemplateIDs
+	sigRequest.SigningRedirectURL = request.SigningRedirectURL
+	if request.Title != nil {
+		sigRequest.Title = *request.Title
+		sigRequest.OriginalTitle = *request.Title
+	}
+	for _, cc := range request.CCs {
+		sigRequest.CCEmailAddresses = append(sigRequest.CCEmailAddresses, cc.Email)
+	}
+	for _, signer := range request.Signers {
+		signer := signer
+		sigRequest.Signatures = append(sigRequest.Signatures, SignatureRequestResponseSignatures{
+			SignerEmailAddress: signer.EmailAddress,
+			SignerName:         &signer.Name,
+			SignerRole:         &signer.Role,
+			StatusCode:         string(SignerStatusAwaitingSignature),
+			HasPin:             signer.Pin != nil,
+		})
+	}
+	return sigRequest
+}
+
+// newRequest creates an authenticated request for the given API path.
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return nil, NewClientError("failed to create request", 0, err)
+	}
+
+	apiKey, err := c.credentials.APIKey(ctx)
+	if err != nil {
+		return nil, NewClientError("failed to get API key", 0, err)
+	}
+
+	req.SetBasicAuth(apiKey, c.password)
+	return req, nil
+}
+
+// do executes the request and returns the response body and status code.
+//
+// Non-2xx responses are converted into an API error via parseErrorResponse.
+// Idempotent requests that fail with a transient network error are retried.
+// Every request is reported to the logger. In dry-run mode, requests other
+// than GET are reported but not sent, and a nil body is returned.
+func (c *Client) do(req *http.Request) ([]byte, int, error) {
+	body, resp, err := c.doResponse(req)
+	if resp == nil {
+		return body, 0, err
+	}
+	return body, resp.StatusCode, err
+}
+
+// doResponse is do, returning the HTTP response instead of its status code.
+//
+// The response body has already been read; resp.Body replays it. The
+// response is nil if none was received, including in dry-run mode.
+func (c *Client) doResponse(req *http.Request) ([]byte, *http.Response, error) {
+	info := RequestInfo{
+		Method: req.Method,
+		Path:   loggedPath(req),
+	}
+	info.CancelReason, _ = req.Context().Value(loggedCancelReasonContextKey).(string)
+
+	if c.dryRun && req.Method != http.MethodGet {
+		if req.Body != nil {
+			// Release streaming bodies, whose writers wait for a reader.
+			req.Body.Close()
+		}
+		info.DryRun = true
+		c.log(req.Context(), info)
+		return nil, nil, nil
+	}
+
+	if c.requestGzip {
+		if err := gzipRequestBody(req); err != nil {
+			err = NewClientError("failed to compress request body", 0, err)
+			info.Err = err
+			c.log(req.Context(), info)
+			return nil, nil, err
+		}
+	}
+
+	start := time.Now()
+	body, resp, attempts, err := c.sendWithRetries(req)
+	if resp != nil {
+		info.StatusCode = resp.StatusCode
+	}
+	info.Duration = time.Since(start)
+	info.Attempt = attempts
+	info.Err = err
+	if err == nil && c.logger != nil {
+		info.WarningsErr = warningsError(c.marshaler, body)
+	}
+	c.log(req.Context(), info)
+
+	return body, resp, err
+}
+
+// doNoBody executes a request whose successful response carries no payload
+// the caller needs, such as cancel or delete operations.
+//
+// Any 2xx response is a success regardless of its body, which may be empty,
+// "{}" or not JSON at all. Error responses are still parsed as usual.
+func (c *Client) doNoBody(req *http.Request) error {
+	_, _, err := c.do(req)
+	return err
+}
+
+// doStream executes a request whose successful response body is returned
+// unread, for streaming large payloads such as files to the caller, who must
+// close it.
+//
+// Error responses are read and parsed as usual. Streaming requests are not
+// retried, since a partially consumed body cannot be replayed.
+func (c *Client) doStream(req *http.Request) (*http.Response, error) {
+	info := RequestInfo{
+		Method:  req.Method,
+		Path:    loggedPath(req),
+		Attempt: 1,
+	}
+	start := time.Now()
+
+	resp, err := c.httpClientFor(req.Context()).Do(req)
+	if err != nil {
+		err = NewClientError("failed to execute request", 0, err)
+	} else {
+		c.lastStatusCode.Store(int64(resp.StatusCode))
+		info.StatusCode = resp.StatusCode
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if readErr != nil {
+				err = NewClientError("failed to read response body", resp.StatusCode, readErr)
+			} else {
+				err = c.parseErrorResponse(body, resp.StatusCode)
+				if apiErr, ok := err.(ErrorResponseError); ok && resp.StatusCode == http.StatusTooManyRequests {
+					err = newRateLimitError(apiErr, resp.Header)
+				}
+			}
+			resp = nil
+		}
+	}
+
+	info.Duration = time.Since(start)
+	info.Err = err
+	c.log(req.Context(), info)
+
+	return resp, err
+}
+
+// send performs the HTTP round trip for do.
+func (c *Client) send(req *http.Request) ([]byte, *http.Response, error) {
+	resp, err := c.httpClientFor(req.Context()).Do(req)
+	if err != nil {
+		clientErr := NewClientError("failed to execute request", 0, err)
+		clientErr.retryable = req.Context().Err() == nil && isTransientNetworkError(err) && isIdempotent(req)
+		return nil, nil, clientErr
+	}
+	defer resp.Body.Close()
+
+	c.lastStatusCode.Store(int64(resp.StatusCode))
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, NewClientError("failed to read response body", resp.StatusCode, err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := c.parseErrorResponse(body, resp.StatusCode)
+		if apiErr, ok := err.(ErrorResponseError); ok && resp.StatusCode == http.StatusTooManyRequests {
+			err = newRateLimitError(apiErr, resp.Header)
+		}
+		return nil, resp, err
+	}
+
+	return body, resp, nil
+}
+
+// parseResponse parses a JSON response from the Dropbox Sign API, extracting the main payload and any warnings.
+//
+// This utility function handles the common pattern of Dropbox Sign API responses which
+// contain the main data under a specific key (e.g., "signature_request") and optional
+// warnings at the top level.
+func parseResponse[T any](body []byte, key string) (*T, []WarningResponse, error) {
+	return parseResponseWith[T](jsonMarshaler{}, body, key)
+}
+
+// parseResponseWith is parseResponse using the given Marshaler.
+func parseResponseWith[T any](m Marshaler, body []byte, key string) (*T, []WarningResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	if err := m.Unmarshal(body, &rawResponse); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Extract main payload by key
+	payload, ok := rawResponse[key]
+	if !ok {
+		return nil, nil, fmt.Errorf("missing key '%s' in response", key)
+	}
+
+	// Deserialize the payload into T
+	var result T
+	if err := m.Unmarshal(payload, &result); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	return &result, decodeWarnings(m, rawResponse["warnings"]), nil
+}
+
+// parseListResponse parses a JSON list response from the Dropbox Sign API.
+//
+// Unlike parseResponse, list responses spread their payload across several
+// top-level keys (e.g. "signature_requests" and "list_info"), so the whole
+// body is decoded into T and warnings are extracted separately.
+func parseListResponse[T any](m Marshaler, body []byte) (*T, []WarningResponse, error) {
+	var result T
+	if err := m.Unmarshal(body, &result); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	var rawResponse map[string]json.RawMessage
+	if err := m.Unmarshal(body, &rawResponse); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, decodeWarnings(m, rawResponse["warnings"]), nil
+}
+
+// parseErrorResponse parses an error response from the Dropbox Sign API.
+//
+// Both the {"error": {...}} and {"errors": [...]} shapes are accepted. Bodies
+// that are valid JSON of another shape still produce an ErrorResponseError,
+// named "unknown_error", whose Details.Raw field holds the body for inspection.
+func (c *Client) parseErrorResponse(body []byte, statusCode int) error {
+	var errResp ErrorResponse
+	if err := c.marshaler.Unmarshal(body, &errResp); err != nil {
+		return NewClientError(fmt.Sprintf("failed to parse error response: %s", c.truncateErrorBody(body)), statusCode, err)
+	}
+
+	apiErr := errResp.Error
+	if apiErr.ErrorName == "" && apiErr.ErrorMsg == "" && len(errResp.Errors) > 0 {
+		apiErr = errResp.Errors[0]
+	}
+	if apiErr.ErrorName == "" && apiErr.ErrorMsg == "" {
+		apiErr.ErrorName = "unknown_error"
+		apiErr.ErrorMsg = http.StatusText(statusCode)
+	}
+
+	// Warnings are optional context, so an unexpected shape is ignored
+	// rather than hiding the error itself.
+	details := &ErrorDetails{Raw: json.RawMessage(body)}
+	var withWarnings struct {
+		Warnings []WarningResponse `json:"warnings"`
+	}
+	if err := c.marshaler.Unmarshal(body, &withWarnings); err == nil {
+		details.Warnings = withWarnings.Warnings
+	}
+
+	apiErr.Status = statusCode
+	apiErr.Details = details
+	return apiErr
+}
+
+// truncateErrorBody returns the body as a string, truncated to the client's
+// maximum error body length. The cut backs off to the start of a UTF-8
+// character so that a multi-byte character is never split.
+func (c *Client) truncateErrorBody(body []byte) string {
+	if c.maxErrorBodyLength <= 0 || len(body) <= c.maxErrorBodyLength {
+		return string(body)
+	}
+	end := c.maxErrorBodyLength
+	for end > 0 && end > c.maxErrorBodyLength-utf8.UTFMax && !utf8.RuneStart(body[end]) {
+		end--
+	}
+	return fmt.Sprintf("%s... (truncated, %d bytes total)", body[:end], len(body))
+}
+
+// Marshaler encodes and decodes the JSON bodies exchanged with the API.
+//
+// It allows replacing encoding/json with a faster, API-compatible
+// implementation such as jsoniter.
+type Marshaler interface {
+	// Marshal returns the JSON encoding of v
+	Marshal(v any) ([]byte, error)
+	// Unmarshal parses the JSON-encoded data and stores the result in v
+	Unmarshal(data []byte, v any) error
+}
+
+// jsonMarshaler is the default Marshaler backed by encoding/json.
+type jsonMarshaler struct{}
+
+func (jsonMarshaler) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
 
-	errResp.Error.Status = statusCode
-	return errResp.Error
+func (jsonMarshaler) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
 }
@@ -3,18 +3,25 @@ package dropboxsign
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+	"unicode/utf8"
 )
 
 func TestNewClient(t *testing.T) {
 	apiKey := "test-api-key"
 	client := NewClient(apiKey)
 
-	if client.apiKey != apiKey {
-		t.Errorf("expected apiKey %s, got %s", apiKey, client.apiKey)
+	if client.credentials != StaticAPIKey(apiKey) {
+		t.Errorf("expected apiKey %s, got %v", apiKey, client.credentials)
 	}
 
 	if client.baseURL != APIBaseURL {
@@ -43,6 +50,152 @@ func TestClientWithBaseURL(t *testing.T) {
 	}
 }
 
+func TestClientWithAPIVersion(t *testing.T) {
+	client := NewClient("test-api-key").WithAPIVersion("v4")
+
+	if expected := APIHost + "/v4"; client.baseURL != expected {
+		t.Errorf("expected baseURL %s, got %s", expected, client.baseURL)
+	}
+
+	client = NewClient("test-api-key").WithBaseURL("https://custom.api.com/v3").WithAPIVersion("v4")
+
+	if expected := "https://custom.api.com/v4"; client.baseURL != expected {
+		t.Errorf("expected baseURL %s, got %s", expected, client.baseURL)
+	}
+}
+
+func TestClientWithRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		expected string
+	}{
+		{
+			name:     "us",
+			client:   NewClient("test-api-key").WithRegion(RegionUS),
+			expected: APIBaseURL,
+		},
+		{
+			name:     "custom host",
+			client:   NewClient("test-api-key").WithRegion(Region("https://sign.example.eu/")),
+			expected: "https://sign.example.eu/v3",
+		},
+		{
+			name:     "region after base url",
+			client:   NewClient("test-api-key").WithBaseURL("https://custom.api.com/v3").WithRegion(RegionUS),
+			expected: APIBaseURL,
+		},
+		{
+			name:     "base url after region",
+			client:   NewClient("test-api-key").WithRegion(RegionUS).WithBaseURL("https://custom.api.com/v3"),
+			expected: "https://custom.api.com/v3",
+		},
+		{
+			name:     "api version",
+			client:   NewClient("test-api-key").WithAPIVersion("v4").WithRegion(RegionUS),
+			expected: APIHost + "/v4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.client.baseURL != tt.expected {
+				t.Errorf("expected baseURL %s, got %s", tt.expected, tt.client.baseURL)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestWithDisableKeepAlives(t *testing.T) {
+	client := NewClient("test-api-key").WithDisableKeepAlives(true)
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", client.httpClient.Transport)
+	}
+	if !transport.DisableKeepAlives {
+		t.Error("expected keep-alives to be disabled")
+	}
+	if transport.MaxIdleConns != 10 {
+		t.Errorf("expected other transport settings to be kept, got MaxIdleConns %d", transport.MaxIdleConns)
+	}
+
+	custom := &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}
+	client = NewClient("test-api-key").WithHTTPClient(custom).WithDisableKeepAlives(true)
+	if _, ok := client.httpClient.Transport.(roundTripperFunc); !ok {
+		t.Errorf("expected custom transport to be left unchanged, got %T", client.httpClient.Transport)
+	}
+
+	owned := &http.Client{Timeout: time.Minute}
+	client = NewClient("test-api-key").WithHTTPClient(owned).WithDisableKeepAlives(true)
+	if owned.Transport != nil {
+		t.Errorf("expected the caller's HTTP client to be left unchanged, got transport %T", owned.Transport)
+	}
+	if client.httpClient == owned || client.httpClient.Timeout != time.Minute {
+		t.Errorf("expected a copy of the caller's HTTP client, got %+v", client.httpClient)
+	}
+}
+
+func TestContextHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "test-sig-req-id"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	used := false
+	tenantClient := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			used = true
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+
+	ctx := WithHTTPClient(context.Background(), tenantClient)
+	if _, _, err := client.GetSignatureRequest(ctx, "test-sig-req-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !used {
+		t.Error("expected the context HTTP client to be used")
+	}
+}
+
+func TestWithBasicAuthPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		expected string
+	}{
+		{name: "default", client: NewClient("test-api-key"), expected: ""},
+		{name: "custom", client: NewClient("test-api-key").WithBasicAuthPassword("gateway-secret"), expected: "gateway-secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.client.newRequest(context.Background(), http.MethodGet, "/account", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			username, password, ok := req.BasicAuth()
+			if !ok || username != "test-api-key" || password != tt.expected {
+				t.Errorf("expected basic auth test-api-key:%q, got %q:%q", tt.expected, username, password)
+			}
+		})
+	}
+}
+
 func TestGetSignatureRequest_Success(t *testing.T) {
 	// Create a mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -75,6 +228,13 @@ func TestGetSignatureRequest_Success(t *testing.T) {
 				"cc_email_addresses":   []string{},
 				"metadata":             map[string]string{},
 				"created_at":           1234567890,
+				"allow_reassign":       true,
+				"custom_fields": []map[string]interface{}{
+					{"type": "text", "name": "company_name", "value": "Acme Corp"},
+				},
+				"attachments": []map[string]interface{}{
+					{"id": "attachment-1", "signer": "test@example.com", "name": "Photo ID", "required": true},
+				},
 				"signatures": []map[string]interface{}{
 					{
 						"signature_id":         "sig-1",
@@ -116,6 +276,18 @@ func TestGetSignatureRequest_Success(t *testing.T) {
 		t.Errorf("expected title 'Test Document', got %s", sigRequest.Title)
 	}
 
+	if sigRequest.AllowReassign == nil || !*sigRequest.AllowReassign {
+		t.Errorf("expected allow_reassign true, got %v", sigRequest.AllowReassign)
+	}
+
+	if len(sigRequest.CustomFields) != 1 || sigRequest.CustomFields[0].Name != "company_name" {
+		t.Errorf("expected custom field company_name, got %+v", sigRequest.CustomFields)
+	}
+
+	if len(sigRequest.Attachments) != 1 || sigRequest.Attachments[0].Name != "Photo ID" {
+		t.Errorf("expected attachment Photo ID, got %+v", sigRequest.Attachments)
+	}
+
 	if len(warnings) != 0 {
 		t.Errorf("expected no warnings, got %d", len(warnings))
 	}
@@ -307,137 +479,1571 @@ func TestSendWithTemplate_BadRequest(t *testing.T) {
 	}
 }
 
-func TestCancelIncompleteSignatureRequest_Success(t *testing.T) {
-	// Create a mock server
+func TestCreateEmbeddedWithTemplateAndURLs(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			t.Errorf("expected POST request, got %s", r.Method)
-		}
+		w.Header().Set("Content-Type", "application/json")
 
-		if r.URL.Path != "/v3/signature_request/cancel/test-sig-req-id" {
+		var response map[string]interface{}
+		switch r.URL.Path {
+		case "/v3/signature_request/create_embedded_with_template":
+			response = map[string]interface{}{
+				"signature_request": map[string]interface{}{
+					"signature_request_id": "embedded-sig-req-id",
+					"signatures": []map[string]interface{}{
+						{"signature_id": "sig-1", "signer_email_address": "a@example.com", "status_code": "awaiting_signature"},
+						{"signature_id": "sig-2", "signer_email_address": "b@example.com", "status_code": "awaiting_signature"},
+					},
+				},
+			}
+		case "/v3/embedded/sign_url/sig-1", "/v3/embedded/sign_url/sig-2":
+			response = map[string]interface{}{
+				"embedded": map[string]interface{}{
+					"sign_url":   "https://example.com/sign/" + strings.TrimPrefix(r.URL.Path, "/v3/embedded/sign_url/"),
+					"expires_at": 1234567890,
+				},
+			}
+		default:
 			t.Errorf("unexpected path: %s", r.URL.Path)
 		}
 
-		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
 	}))
 	defer server.Close()
 
 	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
 
-	ctx := context.Background()
-	err := client.CancelIncompleteSignatureRequest(ctx, "test-sig-req-id")
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"}).
+		WithClientID("client-id")
 
+	sigRequest, signURLs, _, err := client.CreateEmbeddedWithTemplateAndURLs(context.Background(), request)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+
+	if sigRequest.SignatureRequestID != "embedded-sig-req-id" {
+		t.Errorf("expected signature_request_id 'embedded-sig-req-id', got %s", sigRequest.SignatureRequestID)
+	}
+
+	if !sigRequest.IsEmbedded {
+		t.Error("expected IsEmbedded to be set")
+	}
+
+	for _, signatureID := range []string{"sig-1", "sig-2"} {
+		if expected := "https://example.com/sign/" + signatureID; signURLs[signatureID] != expected {
+			t.Errorf("expected sign URL %s for %s, got %s", expected, signatureID, signURLs[signatureID])
+		}
+	}
 }
 
-func TestParseResponse(t *testing.T) {
-	jsonData := []byte(`{
-		"signature_request": {
-			"signature_request_id": "test-id",
-			"title": "Test",
-			"original_title": "Test",
-			"is_complete": false,
-			"is_declined": false,
-			"has_error": false,
-			"files_url": "https://example.com",
-			"details_url": "https://example.com",
-			"cc_email_addresses": [],
-			"metadata": {},
-			"created_at": 1234567890,
-			"signatures": []
-		},
-		"warnings": [
-			{
-				"warning_msg": "Test warning",
-				"warning_name": "test_warning"
-			}
-		]
-	}`)
+func TestCreateEmbeddedWithTemplate_RequiresClientID(t *testing.T) {
+	client := NewClient("test-api-key")
 
-	sigRequest, warnings, err := parseResponse[SignatureRequestResponse](jsonData, "signature_request")
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"})
 
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	if _, _, err := client.CreateEmbeddedWithTemplate(context.Background(), request); err == nil {
+		t.Fatal("expected error for missing client_id, got nil")
 	}
+}
 
-	if sigRequest == nil {
-		t.Fatal("expected signature request, got nil")
+func TestTestModeGuard(t *testing.T) {
+	var testMode *bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request SendSignatureRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		testMode = request.TestMode
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	isTestKey := func(apiKey string) bool { return strings.HasPrefix(apiKey, "test_") }
+
+	tests := []struct {
+		name         string
+		apiKey       string
+		request      *SendSignatureRequest
+		wantErr      bool
+		wantTestMode *bool
+	}{
+		{name: "test key forces test mode", apiKey: "test_key", request: newValidRequest(), wantTestMode: boolPtr(true)},
+		{name: "test key keeps test mode", apiKey: "test_key", request: newValidRequest().WithTestMode(true), wantTestMode: boolPtr(true)},
+		{name: "test key rejects live request", apiKey: "test_key", request: newValidRequest().WithTestMode(false), wantErr: true},
+		{name: "live key unchanged", apiKey: "live_key", request: newValidRequest().WithTestMode(false), wantTestMode: boolPtr(false)},
 	}
 
-	if sigRequest.SignatureRequestID != "test-id" {
-		t.Errorf("expected signature_request_id 'test-id', got %s", sigRequest.SignatureRequestID)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testMode = nil
+			client := NewClient(tt.apiKey).WithBaseURL(server.URL + "/v3").WithTestModeGuard(isTestKey)
+			original := tt.request.TestMode
+
+			_, _, err := client.SendWithTemplate(context.Background(), tt.request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if testMode != nil {
+					t.Error("expected request not to be sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if testMode == nil || *testMode != *tt.wantTestMode {
+				t.Errorf("expected test_mode %v, got %v", *tt.wantTestMode, testMode)
+			}
+			if tt.request.TestMode != original {
+				t.Error("expected caller's request to be left unmodified")
+			}
+		})
 	}
+}
 
-	if len(warnings) != 1 {
-		t.Fatalf("expected 1 warning, got %d", len(warnings))
+func TestSendWithTemplate_TrimsWhitespace(t *testing.T) {
+	var received SendSignatureRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", " john@example.com\n")
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id\n"}).
+		WithCCs([]SubCC{NewSubCC("Legal", "legal@example.com ")})
+
+	if _, _, err := client.SendWithTemplate(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if warnings[0].WarningName != "test_warning" {
-		t.Errorf("expected warning_name 'test_warning', got %s", warnings[0].WarningName)
+	if received.TemplateIDs[0] != "template-id" {
+		t.Errorf("expected trimmed template ID, got %q", received.TemplateIDs[0])
+	}
+	if received.Signers[0].EmailAddress != "john@example.com" {
+		t.Errorf("expected trimmed signer email, got %q", received.Signers[0].EmailAddress)
+	}
+	if received.CCs[0].Email != "legal@example.com" {
+		t.Errorf("expected trimmed CC email, got %q", received.CCs[0].Email)
+	}
+	if request.TemplateIDs[0] != "template-id\n" || request.Signers[0].EmailAddress != " john@example.com\n" {
+		t.Error("expected caller's request to be left unmodified")
 	}
 }
 
-func TestParseResponse_MissingKey(t *testing.T) {
-	jsonData := []byte(`{"other_key": {}}`)
+func TestSendWithTemplate_EmptyTemplateID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
 
-	_, _, err := parseResponse[SignatureRequestResponse](jsonData, "signature_request")
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
 
-	if err == nil {
-		t.Fatal("expected error for missing key, got nil")
+	request := NewSendSignatureRequest(newValidRequest().Signers, []string{"template-id", " \t"})
+	_, _, err := client.SendWithTemplate(context.Background(), request)
+	if err == nil || !strings.Contains(err.Error(), "template_ids[1] is empty") {
+		t.Errorf("expected empty template ID error, got %v", err)
 	}
 }
 
-func TestErrorResponseError_Error(t *testing.T) {
+func TestWithTestModeFromEnv(t *testing.T) {
+	var testMode *bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request SendSignatureRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		testMode = request.TestMode
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
 	tests := []struct {
-		name     string
-		err      ErrorResponseError
-		expected string
+		name         string
+		value        string
+		request      *SendSignatureRequest
+		wantErr      bool
+		wantTestMode *bool
 	}{
-		{
-			name: "with path",
-			err: ErrorResponseError{
-				ErrorName: "validation_error",
-				ErrorPath: stringPtr("signers[0].email"),
-				ErrorMsg:  "Invalid email address",
-			},
-			expected: "validation_error (signers[0].email): Invalid email address",
-		},
-		{
-			name: "without path",
-			err: ErrorResponseError{
-				ErrorName: "not_found",
-				ErrorMsg:  "Resource not found",
-			},
-			expected: "not_found: Resource not found",
-		},
+		{name: "truthy forces test mode", value: "1", request: newValidRequest(), wantTestMode: boolPtr(true)},
+		{name: "truthy rejects live request", value: "true", request: newValidRequest().WithTestMode(false), wantErr: true},
+		{name: "false leaves request unchanged", value: "0", request: newValidRequest().WithTestMode(false), wantTestMode: boolPtr(false)},
+		{name: "invalid leaves request unchanged", value: "maybe", request: newValidRequest().WithTestMode(false), wantTestMode: boolPtr(false)},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.err.Error(); got != tt.expected {
-				t.Errorf("expected %q, got %q", tt.expected, got)
+			testMode = nil
+			t.Setenv("DROPBOXSIGN_TEST_MODE", tt.value)
+			client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithTestModeFromEnv("DROPBOXSIGN_TEST_MODE")
+
+			_, _, err := client.SendWithTemplate(context.Background(), tt.request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if testMode != nil {
+					t.Error("expected request not to be sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if testMode == nil || *testMode != *tt.wantTestMode {
+				t.Errorf("expected test_mode %v, got %v", *tt.wantTestMode, testMode)
 			}
 		})
 	}
 }
 
-func TestWarningResponse_String(t *testing.T) {
-	warning := WarningResponse{
-		WarningMsg:  "This is a test warning",
-		WarningName: "test_warning",
+func TestResendWithChanges(t *testing.T) {
+	var calls []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+
+		if r.URL.Path == "/v3/signature_request/send_with_template" {
+			var request SendSignatureRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			if request.Title == nil || *request.Title != "Fixed title" {
+				t.Errorf("expected overridden title, got %v", request.Title)
+			}
+			if request.Metadata["customer_id"] != "42" {
+				t.Errorf("expected metadata to be preserved, got %v", request.Metadata)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "new123"}}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	original := &SignatureRequestResponse{
+		SignatureRequestID: "old123",
+		Title:              "Fixed titel",
+		Metadata:           map[string]string{"customer_id": "42"},
+		TemplateIDs:        []string{"template-id"},
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "john@example.com", SignerName: stringPtr("John Doe"), SignerRole: stringPtr("Signer")},
+		},
 	}
 
-	expected := "This is a test warning (test_warning)"
-	if got := warning.String(); got != expected {
-		t.Errorf("expected %q, got %q", expected, got)
+	resent, _, err := client.ResendWithChanges(context.Background(), original, func(r *SendSignatureRequest) {
+		r.WithTitle("Fixed title")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resent.SignatureRequestID != "new123" {
+		t.Errorf("expected new123, got %s", resent.SignatureRequestID)
+	}
+
+	expected := []string{"/v3/signature_request/send_with_template", "/v3/signature_request/cancel/old123"}
+	if strings.Join(calls, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected calls %v, got %v", expected, calls)
 	}
 }
 
-func TestIsNotFound(t *testing.T) {
-	tests := []struct {
-		name     string
+func TestCancelIncompleteSignatureRequest_Success(t *testing.T) {
+	// Create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v3/signature_request/cancel/test-sig-req-id" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	ctx := context.Background()
+	err := client.CancelIncompleteSignatureRequest(ctx, "test-sig-req-id")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status := client.LastStatusCode(); status != http.StatusOK {
+		t.Errorf("expected last status code 200, got %d", status)
+	}
+}
+
+func TestRemoveSignatureRequestAccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path == "/v3/signature_request/remove/missing" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			if _, err := w.Write([]byte(`{"error": {"error_msg": "Not found", "error_name": "not_found"}}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+			return
+		}
+		if r.URL.Path != "/v3/signature_request/remove/test-sig-req-id" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	if err := client.RemoveSignatureRequestAccess(context.Background(), "test-sig-req-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.RemoveSignatureRequestAccess(context.Background(), "missing"); !IsNotFound(err) {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestCancelIncompleteSignatureRequest_Reason(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "test-sig-req-id"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithLogger(logger)
+
+	ctx := WithCancelReason(context.Background(), "document superseded")
+	if _, _, err := client.GetSignatureRequest(ctx, "test-sig-req-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.CancelIncompleteSignatureRequest(ctx, "test-sig-req-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.infos) != 2 {
+		t.Fatalf("expected 2 logged requests, got %d", len(logger.infos))
+	}
+	if reason := logger.infos[0].CancelReason; reason != "" {
+		t.Errorf("expected no cancel reason on the get request, got %q", reason)
+	}
+	if reason := logger.infos[1].CancelReason; reason != "document superseded" {
+		t.Errorf("expected cancel reason %q, got %q", "document superseded", reason)
+	}
+}
+
+func TestDoNoBody(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    bool
+	}{
+		{name: "empty body", statusCode: http.StatusOK, body: ""},
+		{name: "empty object", statusCode: http.StatusOK, body: "{}"},
+		{name: "plain text", statusCode: http.StatusOK, body: "OK\n"},
+		{name: "no content", statusCode: http.StatusNoContent, body: ""},
+		{name: "error", statusCode: http.StatusNotFound, body: `{"error": {"error_msg": "Not found", "error_name": "not_found"}}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				if _, err := w.Write([]byte(tt.body)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+			req, err := client.newRequest(context.Background(), http.MethodPost, "/template/delete/template-id", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			err = client.doNoBody(req)
+			if tt.wantErr {
+				if !IsNotFound(err) {
+					t.Errorf("expected not found error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestLastStatusCode_Created(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "new-sig-req-id"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	if status := client.LastStatusCode(); status != 0 {
+		t.Errorf("expected last status code 0 before any call, got %d", status)
+	}
+
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"})
+
+	if _, _, err := client.SendWithTemplate(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status := client.LastStatusCode(); status != http.StatusCreated {
+		t.Errorf("expected last status code 201, got %d", status)
+	}
+}
+
+type recordingLogger struct {
+	mu    sync.Mutex
+	infos []RequestInfo
+}
+
+func (l *recordingLogger) Log(_ context.Context, info RequestInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.infos = append(l.infos, info)
+}
+
+func TestDryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request in dry-run mode: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithDryRun(true).WithLogger(logger)
+
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"}).
+		WithTitle("Test Document")
+
+	ctx := context.Background()
+	sigRequest, _, err := client.SendWithTemplate(ctx, request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sigRequest.Title != "Test Document" {
+		t.Errorf("expected title 'Test Document', got %s", sigRequest.Title)
+	}
+
+	if len(sigRequest.Signatures) != 1 || sigRequest.Signatures[0].SignerEmailAddress != "john@example.com" {
+		t.Errorf("expected synthetic signature for john@example.com, got %+v", sigRequest.Signatures)
+	}
+
+	if err := client.CancelIncompleteSignatureRequest(ctx, "test-sig-req-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.infos) != 2 {
+		t.Fatalf("expected 2 logged requests, got %d", len(logger.infos))
+	}
+
+	for _, info := range logger.infos {
+		if !info.DryRun {
+			t.Errorf("expected dry-run request, got %+v", info)
+		}
+	}
+
+	if logger.infos[1].Path != "/v3/signature_request/cancel/test-sig-req-id" {
+		t.Errorf("unexpected logged path: %s", logger.infos[1].Path)
+	}
+}
+
+func TestDryRun_CreateEmbeddedWithTemplateAndURLs(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		t.Errorf("unexpected request in dry-run mode: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithDryRun(true)
+
+	signers := []SubSignatureRequestTemplateSigner{
+		NewSubSignatureRequestTemplateSigner("Client", "Jane Doe", "jane@example.com"),
+		NewSubSignatureRequestTemplateSigner("Witness", "John Doe", "john@example.com"),
+	}
+	request := NewSendSignatureRequest(signers, []string{"template-id"}).WithClientID("client-id")
+
+	sigRequest, signURLs, _, err := client.CreateEmbeddedWithTemplateAndURLs(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sigRequest.Signatures) != 2 {
+		t.Errorf("expected 2 synthetic signatures, got %d", len(sigRequest.Signatures))
+	}
+	if signURLs == nil || len(signURLs) != 0 {
+		t.Errorf("expected an empty URL map, got %v", signURLs)
+	}
+	if n := hits.Load(); n != 0 {
+		t.Errorf("expected no requests to reach the server, got %d", n)
+	}
+}
+
+func TestDryRun_Validates(t *testing.T) {
+	client := NewClient("test-api-key").WithDryRun(true)
+
+	request := newValidRequest().WithSigningOptions(&SubSigningOptions{})
+
+	if _, _, err := client.SendWithTemplate(context.Background(), request); err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+}
+
+func TestRemindSignatureRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/remind/abc123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if body["email_address"] == "signed@example.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			if _, err := w.Write([]byte(`{"error": {"error_msg": "This signer has already signed", "error_name": "bad_request"}}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+			return
+		}
+		if body["name"] != "Jane Doe" {
+			t.Errorf("expected name Jane Doe, got %q", body["name"])
+		}
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	sigRequest, _, err := client.RemindSignatureRequest(context.Background(), "abc123", "jane@example.com", WithRemindName("Jane Doe"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sigRequest.SignatureRequestID != "abc123" {
+		t.Errorf("expected abc123, got %s", sigRequest.SignatureRequestID)
+	}
+
+	_, _, err = client.RemindSignatureRequest(context.Background(), "abc123", "signed@example.com")
+	if !IsSignerAlreadySigned(err) {
+		t.Fatalf("expected SignerAlreadySignedError, got %v", err)
+	}
+	var apiErr ErrorResponseError
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusBadRequest {
+		t.Errorf("expected the API error to be wrapped, got %v", err)
+	}
+	if !IsSignerAlreadySigned(fmt.Errorf("remind: %w", err)) {
+		t.Errorf("expected a wrapped SignerAlreadySignedError to be recognized")
+	}
+	if _, ok := isAlreadySignedError(fmt.Errorf("remind: %w", apiErr)); !ok {
+		t.Errorf("expected a wrapped already signed API error to be recognized")
+	}
+}
+
+func TestRemindAllPending(t *testing.T) {
+	var reminded []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v3/signature_request/test-sig-req-id":
+			response := map[string]interface{}{
+				"signature_request": map[string]interface{}{
+					"signature_request_id": "test-sig-req-id",
+					"signatures": []map[string]interface{}{
+						{"signature_id": "sig-1", "signer_email_address": "signed@example.com", "status_code": "signed"},
+						{"signature_id": "sig-2", "signer_email_address": "pending@example.com", "status_code": "awaiting_signature"},
+						{"signature_id": "sig-3", "signer_email_address": "declined@example.com", "status_code": "declined"},
+					},
+				},
+			}
+			if err := json.NewEncoder(w).Encode(response); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		case "/v3/signature_request/remind/test-sig-req-id":
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST request, got %s", r.Method)
+			}
+
+			var reqBody map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			reminded = append(reminded, reqBody["email_address"])
+
+			response := map[string]interface{}{
+				"signature_request": map[string]interface{}{
+					"signature_request_id": "test-sig-req-id",
+				},
+			}
+			if err := json.NewEncoder(w).Encode(response); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	if err := client.RemindAllPending(context.Background(), "test-sig-req-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reminded) != 1 || reminded[0] != "pending@example.com" {
+		t.Errorf("expected only pending@example.com to be reminded, got %v", reminded)
+	}
+}
+
+func TestRemindAllPending_StopsOnRateLimit(t *testing.T) {
+	remindCalls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/v3/signature_request/test-sig-req-id" {
+			response := map[string]interface{}{
+				"signature_request": map[string]interface{}{
+					"signature_request_id": "test-sig-req-id",
+					"signatures": []map[string]interface{}{
+						{"signature_id": "sig-1", "signer_email_address": "a@example.com", "status_code": "awaiting_signature"},
+						{"signature_id": "sig-2", "signer_email_address": "b@example.com", "status_code": "awaiting_signature"},
+					},
+				},
+			}
+			if err := json.NewEncoder(w).Encode(response); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+			return
+		}
+
+		remindCalls++
+		w.WriteHeader(http.StatusTooManyRequests)
+		response := ErrorResponse{
+			Error: ErrorResponseError{
+				ErrorMsg:  "Rate limit exceeded",
+				ErrorName: "exceeded_rate",
+			},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	err := client.RemindAllPending(context.Background(), "test-sig-req-id")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if remindCalls != 1 {
+		t.Errorf("expected reminders to stop after rate limit, got %d calls", remindCalls)
+	}
+}
+
+func TestRemindSignatureRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/v3/signature_request/remind/bad-id" {
+			w.WriteHeader(http.StatusNotFound)
+			response := ErrorResponse{
+				Error: ErrorResponseError{
+					ErrorMsg:  "Not found",
+					ErrorName: "not_found",
+				},
+			}
+			if err := json.NewEncoder(w).Encode(response); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+			return
+		}
+
+		response := map[string]interface{}{
+			"signature_request": map[string]interface{}{
+				"signature_request_id": "ok",
+			},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	targets := []ReminderTarget{
+		{SignatureRequestID: "id-1", Email: "a@example.com"},
+		{SignatureRequestID: "bad-id", Email: "b@example.com"},
+		{SignatureRequestID: "id-2", Email: "c@example.com"},
+	}
+
+	errs := client.RemindSignatureRequests(context.Background(), targets, 2)
+
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 failure, got %d: %v", len(errs), errs)
+	}
+
+	if err := errs["bad-id/b@example.com"]; !IsNotFound(err) {
+		t.Errorf("expected NotFound error for bad-id, got %v", err)
+	}
+}
+
+func TestExtendExpiration(t *testing.T) {
+	currentExpiry := time.Now().Add(24 * time.Hour).Unix()
+	var updateBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/v3/signature_request/update/test-sig-req-id" {
+			if err := json.NewDecoder(r.Body).Decode(&updateBody); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+		}
+
+		response := map[string]interface{}{
+			"signature_request": map[string]interface{}{
+				"signature_request_id": "test-sig-req-id",
+				"expires_at":           currentExpiry,
+				"signatures": []map[string]interface{}{
+					{"signature_id": "sig-1", "signer_email_address": "a@example.com", "status_code": "awaiting_signature"},
+				},
+			},
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+	ctx := context.Background()
+
+	if err := client.ExtendExpiration(ctx, "test-sig-req-id", time.Now().Add(-time.Hour)); err == nil {
+		t.Error("expected error for expiry in the past")
+	}
+
+	if err := client.ExtendExpiration(ctx, "test-sig-req-id", time.Now().Add(time.Hour)); err == nil {
+		t.Error("expected error for expiry before the current one")
+	}
+
+	if updateBody != nil {
+		t.Fatal("expected no update call for invalid expiries")
+	}
+
+	newExpiry := time.Now().Add(72 * time.Hour)
+	if err := client.ExtendExpiration(ctx, "test-sig-req-id", newExpiry); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updateBody["signature_id"] != "sig-1" {
+		t.Errorf("expected signature_id sig-1, got %v", updateBody["signature_id"])
+	}
+
+	if expiresAt, ok := updateBody["expires_at"].(float64); !ok || int64(expiresAt) != newExpiry.Unix() {
+		t.Errorf("expected expires_at %d, got %v", newExpiry.Unix(), updateBody["expires_at"])
+	}
+
+	if _, ok := updateBody["email_address"]; ok {
+		t.Error("expected email_address to be omitted")
+	}
+}
+
+func TestUpdateTemplateFiles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/template/update_files/template-id" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("failed to parse multipart form: %v", err)
+		}
+
+		files := r.MultipartForm.File["files[0]"]
+		if len(files) != 1 {
+			t.Fatalf("expected files[0] part, got %v", r.MultipartForm.File)
+		}
+
+		if contentType := files[0].Header.Get("Content-Type"); contentType != "application/pdf" {
+			t.Errorf("expected Content-Type application/pdf, got %s", contentType)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"template": {"template_id": "template-id"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	pdf := []byte("%PDF-1.4\n%fake pdf")
+	if err := client.UpdateTemplateFiles(context.Background(), "template-id", [][]byte{pdf}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/report/create" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		var request CreateReportRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if request.StartDate != "01/01/2024" || request.EndDate != "01/31/2024" {
+			t.Errorf("unexpected date range: %s - %s", request.StartDate, request.EndDate)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"report": {"success": "Your request is being processed. You will receive an email when the report is ready.", "start_date": "01/01/2024", "end_date": "01/31/2024", "report_type": ["document_status"]}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	report, _, err := client.CreateReport(context.Background(), NewCreateReportRequest(start, end, ReportTypeDocumentStatus))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.ReportType) != 1 || report.ReportType[0] != ReportTypeDocumentStatus {
+		t.Errorf("unexpected report types: %v", report.ReportType)
+	}
+}
+
+func TestGetSignatureRequestWithResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-123")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}, "warnings": [{"warning_msg": "Heads up", "warning_name": "heads_up"}]}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	response, err := client.GetSignatureRequestWithResponse(context.Background(), "abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if response.Inner.SignatureRequestID != "abc123" {
+		t.Errorf("expected abc123, got %s", response.Inner.SignatureRequestID)
+	}
+	if len(response.Warnings) != 1 {
+		t.Errorf("expected 1 warning, got %d", len(response.Warnings))
+	}
+	if requestID := response.Response.Header.Get("X-Request-Id"); requestID != "req-123" {
+		t.Errorf("expected X-Request-Id req-123, got %q", requestID)
+	}
+	if _, err := http.ParseTime(response.Response.Header.Get("Date")); err != nil {
+		t.Errorf("expected a Date header: %v", err)
+	}
+
+	body, err := io.ReadAll(response.Response.Body)
+	if err != nil || !strings.Contains(string(body), "abc123") {
+		t.Errorf("expected response body to be replayable, got %q (%v)", body, err)
+	}
+}
+
+func TestCancelByMetadata(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		canceled []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if id, ok := strings.CutPrefix(r.URL.Path, "/v3/signature_request/cancel/"); ok {
+			if id == "locked" {
+				w.WriteHeader(http.StatusConflict)
+				if _, err := w.Write([]byte(`{"error": {"error_msg": "Signature request is being processed", "error_name": "conflict"}}`)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+				return
+			}
+			mu.Lock()
+			canceled = append(canceled, id)
+			mu.Unlock()
+			return
+		}
+
+		if query := r.URL.Query().Get("query"); query != `metadata.customer_id:"cus_123"` {
+			t.Errorf("unexpected query %q", query)
+		}
+
+		var body string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			body = `{"signature_requests": [
+				{"signature_request_id": "match-1", "metadata": {"customer_id": "cus_123"}},
+				{"signature_request_id": "other", "metadata": {"customer_id": "cus_456"}},
+				{"signature_request_id": "complete", "is_complete": true, "metadata": {"customer_id": "cus_123"}}
+			], "list_info": {"page": 1, "num_pages": 2, "page_size": 100}}`
+		case "2":
+			body = `{"signature_requests": [
+				{"signature_request_id": "match-2", "metadata": {"customer_id": "cus_123"}},
+				{"signature_request_id": "locked", "metadata": {"customer_id": "cus_123"}},
+				{"signature_request_id": "untagged"}
+			], "list_info": {"page": 2, "num_pages": 2, "page_size": 100}}`
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	ids, failed, err := client.CancelByMetadata(context.Background(), "customer_id", "cus_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(ids) != 2 || ids[0] != "match-1" || ids[1] != "match-2" {
+		t.Errorf("expected match-1 and match-2 to be canceled, got %v", ids)
+	}
+	if len(canceled) != 2 {
+		t.Errorf("expected 2 cancel calls to succeed, got %v", canceled)
+	}
+	if len(failed) != 1 || failed["locked"] == nil {
+		t.Errorf("expected only locked to fail, got %v", failed)
+	}
+}
+
+func TestCancelByMetadata_ListError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/cancel/") {
+			t.Errorf("unexpected cancel after list error: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		if _, err := w.Write([]byte(`{"error": {"error_msg": "Unauthorized api key", "error_name": "unauthorized"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	ids, failed, err := client.CancelByMetadata(context.Background(), "customer_id", "cus_123")
+	if ids != nil || failed != nil {
+		t.Errorf("expected no canceled or failed IDs, got %v and %v", ids, failed)
+	}
+	if !IsUnauthorized(err) {
+		t.Errorf("expected the list error, got %v", err)
+	}
+}
+
+func TestGetSignatureRequestByCustomID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The API filters by the metadata query; the fake server returns
+		// near matches too, which the client must discard.
+		var body string
+		switch query := r.URL.Query().Get("query"); query {
+		case `metadata.custom_id:"order-3"`:
+			body = `{"signature_requests": [
+				{"signature_request_id": "abc123", "metadata": {"custom_id": "order-3-b"}},
+				{"signature_request_id": "ghi789", "metadata": {"custom_id": "order-3"}}
+			], "list_info": {"page": 1, "num_pages": 1, "page_size": 100}}`
+		case `metadata.custom_id:"order-9"`:
+			body = `{"signature_requests": [], "list_info": {"page": 1, "num_pages": 1, "page_size": 100}}`
+		case `metadata.custom_id:"order-2"`:
+			switch r.URL.Query().Get("page") {
+			case "1":
+				body = `{"signature_requests": [
+					{"signature_request_id": "def456", "metadata": {"custom_id": "order-2"}}
+				], "list_info": {"page": 1, "num_pages": 2, "page_size": 100}}`
+			default:
+				body = `{"signature_requests": [
+					{"signature_request_id": "ghi789", "metadata": {"custom_id": "order-2"}}
+				], "list_info": {"page": 2, "num_pages": 2, "page_size": 100}}`
+			}
+		default:
+			t.Errorf("unexpected query %q", query)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+	ctx := context.Background()
+
+	sigRequest, err := client.GetSignatureRequestByCustomID(ctx, "order-3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sigRequest.SignatureRequestID != "ghi789" {
+		t.Errorf("expected ghi789, got %s", sigRequest.SignatureRequestID)
+	}
+
+	if _, err := client.GetSignatureRequestByCustomID(ctx, "order-9"); !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	_, err = client.GetSignatureRequestByCustomID(ctx, "order-2")
+	if err == nil || !strings.Contains(err.Error(), "def456, ghi789") {
+		t.Errorf("expected error listing both matches, got %v", err)
+	}
+}
+
+func TestEnsureSent(t *testing.T) {
+	var sent []map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+		switch r.URL.Path {
+		case "/v3/signature_request/list":
+			if r.URL.Query().Get("query") == `metadata.custom_id:"order-1"` {
+				body = `{"signature_requests": [
+					{"signature_request_id": "abc123", "metadata": {"custom_id": "order-1"}}
+				], "list_info": {"page": 1, "num_pages": 1, "page_size": 100}}`
+			} else {
+				body = `{"signature_requests": [], "list_info": {"page": 1, "num_pages": 1, "page_size": 100}}`
+			}
+		case "/v3/signature_request/send":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("failed to parse multipart form: %v", err)
+			}
+			sent = append(sent, map[string]string{"custom_id": r.FormValue("metadata[custom_id]")})
+			body = `{"signature_request": {"signature_request_id": "ghi789"}}`
+		case "/v3/signature_request/send_with_template":
+			var request SendSignatureRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			sent = append(sent, request.Metadata)
+			body = `{"signature_request": {"signature_request_id": "def456"}}`
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+	ctx := context.Background()
+
+	sigRequest, _, err := client.EnsureSent(ctx, "order-1", newValidRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sigRequest.SignatureRequestID != "abc123" || len(sent) != 0 {
+		t.Errorf("expected the existing request abc123 without a send, got %s after %d sends", sigRequest.SignatureRequestID, len(sent))
+	}
+
+	request := newValidRequest().WithMetadata(map[string]string{"tenant": "acme"})
+	sigRequest, _, err = client.EnsureSent(ctx, "order-2", request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sigRequest.SignatureRequestID != "def456" {
+		t.Errorf("expected the new request def456, got %s", sigRequest.SignatureRequestID)
+	}
+	if len(sent) != 1 || sent[0]["custom_id"] != "order-2" || sent[0]["tenant"] != "acme" {
+		t.Errorf("expected one send tagged with order-2, got %v", sent)
+	}
+	if _, ok := request.Metadata[CustomIDMetadataKey]; ok {
+		t.Error("expected the caller's request not to be modified")
+	}
+
+	signers := []SubSignatureRequestTemplateSigner{NewSubSignatureRequestTemplateSigner("", "Jane Doe", "jane@example.com")}
+	sigRequest, _, err = client.EnsureSent(ctx, "order-3", NewSendSignatureRequestWithFiles(signers, [][]byte{[]byte("%PDF-1.4")}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sigRequest.SignatureRequestID != "ghi789" || len(sent) != 2 || sent[1]["custom_id"] != "order-3" {
+		t.Errorf("expected a file upload tagged with order-3, got %s after %v", sigRequest.SignatureRequestID, sent)
+	}
+}
+
+func TestListSignatureRequests_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/v3/signature_request/list" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		if page := r.URL.Query().Get("page"); page != "2" {
+			t.Errorf("expected page 2, got %q", page)
+		}
+
+		response := map[string]interface{}{
+			"signature_requests": []map[string]interface{}{
+				{"signature_request_id": "sig-req-1"},
+				{"signature_request_id": "sig-req-2"},
+			},
+			"list_info": map[string]interface{}{
+				"num_pages":   3,
+				"num_results": 6,
+				"page":        2,
+				"page_size":   2,
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	list, _, err := client.ListSignatureRequests(context.Background(), NewListSignatureRequestsOptions().WithPage(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(list.SignatureRequests) != 2 {
+		t.Errorf("expected 2 signature requests, got %d", len(list.SignatureRequests))
+	}
+
+	if !list.ListInfo.HasNextPage() {
+		t.Error("expected another page")
+	}
+
+	if next := list.ListInfo.NextPage(); next != 3 {
+		t.Errorf("expected next page 3, got %d", next)
+	}
+}
+
+func TestListSignatureRequests_AccountID(t *testing.T) {
+	var accountID string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountID = r.URL.Query().Get("account_id")
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_requests": [], "list_info": {"page": 1, "num_pages": 1}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithAccountID("default-account")
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		opts     *ListSignatureRequestsOptions
+		expected string
+	}{
+		{name: "client default", ctx: ctx, expected: "default-account"},
+		{name: "context override", ctx: WithAccountID(ctx, "context-account"), expected: "context-account"},
+		{
+			name:     "options override",
+			ctx:      WithAccountID(ctx, "context-account"),
+			opts:     NewListSignatureRequestsOptions().WithAccountID("options-account"),
+			expected: "options-account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := client.ListSignatureRequests(tt.ctx, tt.opts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if accountID != tt.expected {
+				t.Errorf("expected account_id %q, got %q", tt.expected, accountID)
+			}
+		})
+	}
+}
+
+func TestListInfo_HasNextPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     ListInfo
+		expected bool
+	}{
+		{
+			name:     "first of several pages",
+			info:     ListInfo{Page: 1, NumPages: 3},
+			expected: true,
+		},
+		{
+			name:     "last page",
+			info:     ListInfo{Page: 3, NumPages: 3},
+			expected: false,
+		},
+		{
+			name:     "empty result",
+			info:     ListInfo{Page: 1, NumPages: 0},
+			expected: false,
+		},
+		{
+			name:     "next page URL",
+			info:     ListInfo{Page: 3, NumPages: 3, NextPageURL: stringPtr("https://example.com/next")},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.info.HasNextPage(); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+type countingMarshaler struct {
+	marshalCalls   int
+	unmarshalCalls int
+}
+
+func (m *countingMarshaler) Marshal(v any) ([]byte, error) {
+	m.marshalCalls++
+	return json.Marshal(v)
+}
+
+func (m *countingMarshaler) Unmarshal(data []byte, v any) error {
+	m.unmarshalCalls++
+	return json.Unmarshal(data, v)
+}
+
+func TestClientWithMarshaler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "new-sig-req-id"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	marshaler := &countingMarshaler{}
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithMarshaler(marshaler)
+
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"})
+
+	sigRequest, _, err := client.SendWithTemplate(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sigRequest.SignatureRequestID != "new-sig-req-id" {
+		t.Errorf("expected signature_request_id 'new-sig-req-id', got %s", sigRequest.SignatureRequestID)
+	}
+
+	if marshaler.marshalCalls != 1 {
+		t.Errorf("expected 1 marshal call, got %d", marshaler.marshalCalls)
+	}
+
+	if marshaler.unmarshalCalls == 0 {
+		t.Error("expected custom marshaler to decode the response")
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	jsonData := []byte(`{
+		"signature_request": {
+			"signature_request_id": "test-id",
+			"title": "Test",
+			"original_title": "Test",
+			"is_complete": false,
+			"is_declined": false,
+			"has_error": false,
+			"files_url": "https://example.com",
+			"details_url": "https://example.com",
+			"cc_email_addresses": [],
+			"metadata": {},
+			"created_at": 1234567890,
+			"signatures": []
+		},
+		"warnings": [
+			{
+				"warning_msg": "Test warning",
+				"warning_name": "test_warning"
+			}
+		]
+	}`)
+
+	sigRequest, warnings, err := parseResponse[SignatureRequestResponse](jsonData, "signature_request")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sigRequest == nil {
+		t.Fatal("expected signature request, got nil")
+	}
+
+	if sigRequest.SignatureRequestID != "test-id" {
+		t.Errorf("expected signature_request_id 'test-id', got %s", sigRequest.SignatureRequestID)
+	}
+
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %d", len(warnings))
+	}
+
+	if warnings[0].WarningName != "test_warning" {
+		t.Errorf("expected warning_name 'test_warning', got %s", warnings[0].WarningName)
+	}
+}
+
+func TestParseResponse_MissingKey(t *testing.T) {
+	jsonData := []byte(`{"other_key": {}}`)
+
+	_, _, err := parseResponse[SignatureRequestResponse](jsonData, "signature_request")
+
+	if err == nil {
+		t.Fatal("expected error for missing key, got nil")
+	}
+}
+
+func TestParseErrorResponse_Shapes(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "error object",
+			body:     `{"error": {"error_msg": "Not found", "error_name": "not_found"}}`,
+			expected: "not_found: Not found",
+		},
+		{
+			name:     "errors list",
+			body:     `{"errors": [{"error_msg": "Invalid title", "error_name": "bad_request", "error_path": "title"}]}`,
+			expected: "bad_request (title): Invalid title",
+		},
+		{
+			name:     "unknown shape",
+			body:     `{"message": "upstream failure"}`,
+			expected: "unknown_error: Bad Gateway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.parseErrorResponse([]byte(tt.body), http.StatusBadGateway)
+
+			apiErr, ok := err.(ErrorResponseError)
+			if !ok {
+				t.Fatalf("expected ErrorResponseError, got %T", err)
+			}
+
+			if got := apiErr.Error(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+
+			if apiErr.Details == nil || string(apiErr.Details.Raw) != tt.body {
+				t.Errorf("expected raw body %q, got %+v", tt.body, apiErr.Details)
+			}
+		})
+	}
+}
+
+func TestParseErrorResponse_Warnings(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	body := `{"error": {"error_msg": "Invalid custom field", "error_name": "bad_request"}, "warnings": [{"warning_msg": "Custom field value was truncated", "warning_name": "custom_field_truncated"}]}`
+	apiErr, ok := client.parseErrorResponse([]byte(body), http.StatusBadRequest).(ErrorResponseError)
+	if !ok {
+		t.Fatal("expected ErrorResponseError")
+	}
+	if len(apiErr.Details.Warnings) != 1 || apiErr.Details.Warnings[0].WarningName != "custom_field_truncated" {
+		t.Errorf("expected truncation warning, got %v", apiErr.Details.Warnings)
+	}
+
+	body = `{"error": {"error_msg": "Invalid custom field", "error_name": "bad_request"}, "warnings": "unexpected"}`
+	apiErr, ok = client.parseErrorResponse([]byte(body), http.StatusBadRequest).(ErrorResponseError)
+	if !ok {
+		t.Fatal("expected ErrorResponseError despite malformed warnings")
+	}
+	if apiErr.Details.Warnings != nil {
+		t.Errorf("expected malformed warnings to be ignored, got %v", apiErr.Details.Warnings)
+	}
+}
+
+func TestErrorResponseError_Comparable(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	err := client.parseErrorResponse([]byte(`{"error": {"error_msg": "Not found", "error_name": "not_found"}}`), http.StatusNotFound)
+	same := err
+	if err != same {
+		t.Error("expected an error to equal itself")
+	}
+
+	notFound := ErrorResponseError{Status: http.StatusNotFound, ErrorName: "not_found", ErrorMsg: "Not found"}
+	if err == error(notFound) {
+		t.Error("expected a parsed error with details to differ from a literal without them")
+	}
+	switch error(notFound) {
+	case ErrorResponseError{Status: http.StatusNotFound, ErrorName: "not_found", ErrorMsg: "Not found"}:
+	default:
+		t.Error("expected equal literals to match in a switch")
+	}
+
+	rateLimitErr := newRateLimitError(notFound, http.Header{})
+	if error(rateLimitErr) != error(rateLimitErr) {
+		t.Error("expected a RateLimitError to equal itself")
+	}
+}
+
+func TestParseErrorResponse_TruncatesBody(t *testing.T) {
+	client := NewClient("test-api-key").WithMaxErrorBodyLength(10)
+	body := []byte(strings.Repeat("x", 100))
+
+	err := client.parseErrorResponse(body, http.StatusBadGateway)
+
+	expected := "dropboxsign client error (status 502): failed to parse error response: xxxxxxxxxx... (truncated, 100 bytes total)"
+	if got := err.Error(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestParseErrorResponse_TruncatesAtRuneBoundary(t *testing.T) {
+	client := NewClient("test-api-key").WithMaxErrorBodyLength(10)
+	// "é" is two bytes, so the tenth byte falls inside the fifth one.
+	body := []byte(strings.Repeat("é", 20))
+
+	err := client.parseErrorResponse(body, http.StatusBadGateway)
+
+	expected := "dropboxsign client error (status 502): failed to parse error response: " + strings.Repeat("é", 5) + "... (truncated, 40 bytes total)"
+	if got := err.Error(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	body = []byte("xxxxxxxxx€€€")
+	got := client.truncateErrorBody(body)
+	if !utf8.ValidString(got) || !strings.HasPrefix(got, "xxxxxxxxx...") {
+		t.Errorf("expected the split character to be dropped, got %q", got)
+	}
+}
+
+func TestErrorResponseError_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      ErrorResponseError
+		expected string
+	}{
+		{
+			name: "with path",
+			err: ErrorResponseError{
+				ErrorName: "validation_error",
+				ErrorPath: stringPtr("signers[0].email"),
+				ErrorMsg:  "Invalid email address",
+			},
+			expected: "validation_error (signers[0].email): Invalid email address",
+		},
+		{
+			name: "without path",
+			err: ErrorResponseError{
+				ErrorName: "not_found",
+				ErrorMsg:  "Resource not found",
+			},
+			expected: "not_found: Resource not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWarningResponse_String(t *testing.T) {
+	warning := WarningResponse{
+		WarningMsg:  "This is a test warning",
+		WarningName: "test_warning",
+	}
+
+	expected := "This is a test warning (test_warning)"
+	if got := warning.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name     string
 		err      error
 		expected bool
 	}{
@@ -467,6 +2073,15 @@ func TestIsNotFound(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "wrapped ErrorResponseError with 404",
+			err: fmt.Errorf("get signature request: %w", ErrorResponseError{
+				Status:    http.StatusNotFound,
+				ErrorName: "not_found",
+				ErrorMsg:  "Not found",
+			}),
+			expected: true,
+		},
 		{
 			name:     "other error",
 			err:      http.ErrServerClosed,
@@ -483,6 +2098,35 @@ func TestIsNotFound(t *testing.T) {
 	}
 }
 
+func TestIsRateLimited(t *testing.T) {
+	apiErr := ErrorResponseError{Status: http.StatusTooManyRequests, ErrorName: "exceeded_rate", ErrorMsg: "Rate limit exceeded"}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "ErrorResponseError", err: apiErr, expected: true},
+		{name: "RateLimitError", err: newRateLimitError(apiErr, http.Header{}), expected: true},
+		{name: "wrapped RateLimitError", err: fmt.Errorf("send: %w", newRateLimitError(apiErr, http.Header{})), expected: true},
+		{name: "ClientError", err: NewClientError("rate limited", http.StatusTooManyRequests, nil), expected: true},
+		{name: "other status", err: ErrorResponseError{Status: http.StatusNotFound}, expected: false},
+		{name: "nil", err: nil, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRateLimited(tt.err); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,92 @@
+package dropboxsign
+
+import (
+	"context"
+	"net/http"
+)
+
+// contextKey is the type of keys for values this package stores in a context.
+type contextKey int
+
+const (
+	httpClientContextKey contextKey = iota
+	accountIDContextKey
+	idempotentContextKey
+	cancelReasonContextKey
+	loggedCancelReasonContextKey
+)
+
+// WithHTTPClient returns a copy of ctx that makes API calls using httpClient
+// instead of the client's default HTTP client.
+//
+// This lets multi-tenant services vary the outbound transport (proxies,
+// certificates) per call while sharing a single Client for authentication
+// and configuration.
+//
+// Example:
+//
+//	ctx := dropboxsign.WithHTTPClient(r.Context(), tenantHTTPClient)
+//	sigRequest, _, err := client.GetSignatureRequest(ctx, signatureRequestID)
+func WithHTTPClient(ctx context.Context, httpClient *http.Client) context.Context {
+	return context.WithValue(ctx, httpClientContextKey, httpClient)
+}
+
+// httpClientFor returns the HTTP client set on ctx, or the client's default,
+// wrapped to record its round trips when WithRecorder is set.
+func (c *Client) httpClientFor(ctx context.Context) *http.Client {
+	httpClient := c.httpClient
+	if ctxClient, ok := ctx.Value(httpClientContextKey).(*http.Client); ok && ctxClient != nil {
+		httpClient = ctxClient
+	}
+	if c.recorder != nil {
+		return c.recorder.wrap(httpClient)
+	}
+	return httpClient
+}
+
+// WithAccountID returns a copy of ctx that scopes API calls made with it to
+// the given team member account, overriding the client's default account ID.
+//
+// The account ID is only sent to endpoints that accept one, such as
+// ListSignatureRequests. Use "all" to include every member of the team.
+//
+// Example:
+//
+//	ctx := dropboxsign.WithAccountID(ctx, "member-account-id")
+//	list, _, err := client.ListSignatureRequests(ctx, nil)
+func WithAccountID(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, accountIDContextKey, accountID)
+}
+
+// accountIDFor returns the account ID set on ctx, or the client's default.
+func (c *Client) accountIDFor(ctx context.Context) string {
+	if accountID, ok := ctx.Value(accountIDContextKey).(string); ok && accountID != "" {
+		return accountID
+	}
+	return c.accountID
+}
+
+// WithCancelReason returns a copy of ctx that records reason as the business
+// reason for canceling a signature request, such as "customer churned" or
+// "document superseded".
+//
+// The API does not accept a cancellation reason and signature request
+// metadata cannot be changed after sending, so the reason is not sent to
+// Dropbox Sign. Instead, CancelIncompleteSignatureRequest (and the
+// cancellations made by CancelByMetadata and ResendWithChanges) report it in
+// the CancelReason field of the request's log record, for an audit trail
+// kept through WithLogger. Other requests made with ctx do not carry it.
+//
+// Example:
+//
+//	ctx := dropboxsign.WithCancelReason(ctx, "document superseded")
+//	err := client.CancelIncompleteSignatureRequest(ctx, signatureRequestID)
+func WithCancelReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, cancelReasonContextKey, reason)
+}
+
+// cancelReasonFor returns the cancellation reason set on ctx, if any.
+func cancelReasonFor(ctx context.Context) string {
+	reason, _ := ctx.Value(cancelReasonContextKey).(string)
+	return reason
+}
@@ -0,0 +1,96 @@
+package dropboxsign
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CredentialProvider supplies the API key used to authenticate each request.
+//
+// The client calls APIKey once per request, so a provider backed by a secrets
+// manager picks up a rotated key without the client being rebuilt. Wrap slow
+// providers with NewCachingCredentialProvider. Implementations must be safe
+// for concurrent use.
+type CredentialProvider interface {
+	// APIKey returns the API key to authenticate a request made with ctx
+	APIKey(ctx context.Context) (string, error)
+}
+
+// StaticAPIKey is a CredentialProvider that always returns the same API key.
+// It is what NewClient uses.
+type StaticAPIKey string
+
+// APIKey returns the key.
+func (k StaticAPIKey) APIKey(context.Context) (string, error) {
+	return string(k), nil
+}
+
+// CachingCredentialProvider is a CredentialProvider that caches the API key
+// of another provider for a fixed time.
+type CachingCredentialProvider struct {
+	provider CredentialProvider
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	apiKey    string
+	expiresAt time.Time
+	now       func() time.Time
+}
+
+// NewCachingCredentialProvider returns a provider that fetches the API key
+// from provider at most once every ttl.
+//
+// Errors from provider are not cached, so the next request tries again.
+//
+// Example:
+//
+//	provider := dropboxsign.NewCachingCredentialProvider(secretsProvider, 5*time.Minute)
+//	client := dropboxsign.NewClientWithCredentialProvider(provider)
+func NewCachingCredentialProvider(provider CredentialProvider, ttl time.Duration) *CachingCredentialProvider {
+	return &CachingCredentialProvider{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+// APIKey returns the cached API key, fetching a new one from the wrapped
+// provider once the cached key has expired.
+func (p *CachingCredentialProvider) APIKey(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.apiKey != "" && now.Before(p.expiresAt) {
+		return p.apiKey, nil
+	}
+
+	apiKey, err := p.provider.APIKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	p.apiKey = apiKey
+	p.expiresAt = now.Add(p.ttl)
+	return apiKey, nil
+}
+
+var _ CredentialProvider = (*CachingCredentialProvider)(nil)
+
+// NewClientWithCredentialProvider creates a new Dropbox Sign client that
+// authenticates each request with the API key returned by provider, for
+// deployments whose keys are rotated.
+//
+// It is otherwise configured like NewClient. If provider fails, the request
+// is not sent and the error is returned wrapped in a *ClientError.
+//
+// Example:
+//
+//	client := dropboxsign.NewClientWithCredentialProvider(
+//		dropboxsign.NewCachingCredentialProvider(secretsProvider, 5*time.Minute),
+//	)
+func NewClientWithCredentialProvider(provider CredentialProvider) *Client {
+	c := NewClient("")
+	c.credentials = provider
+	return c
+}
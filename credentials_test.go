@@ -0,0 +1,97 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// rotatingProvider returns a new API key on every call.
+type rotatingProvider struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+}
+
+func (p *rotatingProvider) APIKey(context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	if p.err != nil {
+		return "", p.err
+	}
+	return "key-" + strconv.Itoa(p.calls), nil
+}
+
+func TestNewClientWithCredentialProvider(t *testing.T) {
+	var usernames []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, _, _ := r.BasicAuth()
+		usernames = append(usernames, username)
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"account": {"account_id": "acct-1"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	provider := &rotatingProvider{}
+	client := NewClientWithCredentialProvider(provider).WithBaseURL(server.URL + "/v3")
+
+	for i := 0; i < 2; i++ {
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(usernames) != 2 || usernames[0] != "key-1" || usernames[1] != "key-2" {
+		t.Errorf("expected each request to use the current key, got %v", usernames)
+	}
+
+	provider.err = errors.New("secrets manager unavailable")
+	err := client.Ping(context.Background())
+	var clientErr *ClientError
+	if !errors.As(err, &clientErr) || !errors.Is(err, provider.err) {
+		t.Errorf("expected provider error wrapped in a ClientError, got %v", err)
+	}
+	if len(usernames) != 2 {
+		t.Errorf("expected no request to be sent when the provider fails, got %d", len(usernames))
+	}
+}
+
+func TestCachingCredentialProvider(t *testing.T) {
+	provider := &rotatingProvider{}
+	caching := NewCachingCredentialProvider(provider, time.Minute)
+	now := time.Unix(1700000000, 0)
+	caching.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		advance  time.Duration
+		expected string
+	}{
+		{0, "key-1"},
+		{30 * time.Second, "key-1"},
+		{31 * time.Second, "key-2"},
+	} {
+		now = now.Add(tt.advance)
+		apiKey, err := caching.APIKey(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if apiKey != tt.expected {
+			t.Errorf("expected %s, got %s", tt.expected, apiKey)
+		}
+	}
+
+	provider.err = errors.New("secrets manager unavailable")
+	now = now.Add(2 * time.Minute)
+	if _, err := caching.APIKey(ctx); err == nil {
+		t.Error("expected provider error, got nil")
+	}
+}
@@ -0,0 +1,92 @@
+package dropboxsign
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DebugString returns a multi-line, human-readable summary of the signature
+// request for support tickets: its title, state, dates, metadata, each
+// signer's status and timestamps, and any warnings passed in.
+//
+// Signer email addresses and phone numbers are masked. Metadata is printed
+// as is, so it must not hold personal data if the summary is shared.
+// Timestamps are printed in UTC.
+//
+// Example:
+//
+//	sigRequest, warnings, err := client.GetSignatureRequest(ctx, "signature_request_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(sigRequest.DebugString(warnings...))
+func (r *SignatureRequestResponse) DebugString(warnings ...WarningResponse) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "signature request %s %q\n", r.SignatureRequestID, r.Title)
+	fmt.Fprintf(&b, "  state: %s", r.State())
+	if r.TestMode != nil && *r.TestMode {
+		b.WriteString(" (test mode)")
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "  created: %s\n", debugTime(r.CreatedAt))
+	if r.ExpiresAt != nil {
+		fmt.Fprintf(&b, "  expires: %s\n", debugTime(*r.ExpiresAt))
+	}
+	if len(r.TemplateIDs) > 0 {
+		fmt.Fprintf(&b, "  templates: %s\n", strings.Join(r.TemplateIDs, ", "))
+	}
+
+	if len(r.Metadata) > 0 {
+		keys := make([]string, 0, len(r.Metadata))
+		for key := range r.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		b.WriteString("  metadata:\n")
+		for _, key := range keys {
+			fmt.Fprintf(&b, "    %s=%s\n", key, r.Metadata[key])
+		}
+	}
+
+	b.WriteString("  signers:\n")
+	if len(r.Signatures) == 0 {
+		b.WriteString("    none\n")
+	}
+	for _, signature := range r.Signatures {
+		fmt.Fprintf(&b, "    %s\n", signature)
+		for _, timestamp := range []struct {
+			name  string
+			value *int64
+		}{
+			{"signed", signature.SignedAt},
+			{"last viewed", signature.LastViewedAt},
+			{"last reminded", signature.LastRemindedAt},
+		} {
+			if timestamp.value != nil {
+				fmt.Fprintf(&b, "      %s: %s\n", timestamp.name, debugTime(*timestamp.value))
+			}
+		}
+		if signature.DeclineReason != nil {
+			fmt.Fprintf(&b, "      decline reason: %s\n", *signature.DeclineReason)
+		}
+		if signature.Error != nil {
+			fmt.Fprintf(&b, "      error: %s\n", *signature.Error)
+		}
+	}
+
+	if len(warnings) > 0 {
+		b.WriteString("  warnings:\n")
+		for _, warning := range warnings {
+			fmt.Fprintf(&b, "    %s\n", warning)
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// debugTime formats a Unix timestamp for DebugString.
+func debugTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,40 @@
+package dropboxsign
+
+import "testing"
+
+func TestSignatureRequestResponse_DebugString(t *testing.T) {
+	signedAt := int64(1700000100)
+	testMode := true
+	response := &SignatureRequestResponse{
+		SignatureRequestID: "abc123",
+		Title:              "Contract",
+		TestMode:           &testMode,
+		CreatedAt:          1700000000,
+		TemplateIDs:        []string{"template-id"},
+		Metadata:           map[string]string{"tenant": "acme", "customer_id": "42"},
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "jane@example.com", SignerRole: stringPtr("Signer"), StatusCode: "signed", SignedAt: &signedAt, LastViewedAt: &signedAt},
+			{SignatureID: "sig-2", SignerEmailAddress: "john@example.com", StatusCode: "awaiting_signature", SMSPhoneNumber: stringPtr("+14155550123")},
+		},
+	}
+
+	expected := `signature request abc123 "Contract"
+  state: partially_signed (test mode)
+  created: 2023-11-14T22:13:20Z
+  templates: template-id
+  metadata:
+    customer_id=42
+    tenant=acme
+  signers:
+    signature_id=sig-1 role=Signer email=j***@example.com status=signed
+      signed: 2023-11-14T22:15:00Z
+      last viewed: 2023-11-14T22:15:00Z
+    signature_id=sig-2 email=j***@example.com status=awaiting_signature sms=+*********23
+  warnings:
+    Missing CC (cc_missing)`
+
+	got := response.DebugString(WarningResponse{WarningMsg: "Missing CC", WarningName: "cc_missing"})
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,141 @@
+package dropboxsign
+
+// SignatureChangeType identifies the kind of change detected between two
+// signature request snapshots.
+type SignatureChangeType string
+
+const (
+	// SignatureChangeTypeAdded means a signature appeared that was not in the earlier snapshot
+	SignatureChangeTypeAdded SignatureChangeType = "added"
+	// SignatureChangeTypeRemoved means a signature from the earlier snapshot is no longer present
+	SignatureChangeTypeRemoved SignatureChangeType = "removed"
+	// SignatureChangeTypeReassigned means a signature was reassigned to a different signer
+	SignatureChangeTypeReassigned SignatureChangeType = "reassigned"
+	// SignatureChangeTypeSigned means a signer newly signed
+	SignatureChangeTypeSigned SignatureChangeType = "signed"
+	// SignatureChangeTypeDeclined means a signer newly declined
+	SignatureChangeTypeDeclined SignatureChangeType = "declined"
+	// SignatureChangeTypeStatusChanged means a signer's status changed to something other than signed or declined
+	SignatureChangeTypeStatusChanged SignatureChangeType = "status_changed"
+	// SignatureChangeTypeCompleted means the signature request as a whole became complete
+	SignatureChangeTypeCompleted SignatureChangeType = "completed"
+)
+
+// SignatureChange describes a single difference between two signature request snapshots.
+type SignatureChange struct {
+	// Type is the kind of change
+	Type SignatureChangeType
+	// SignatureID is the ID of the affected signature (empty for request-level changes)
+	SignatureID string
+	// SignerEmailAddress is the signer's email address in the later snapshot
+	// (or the earlier one for removed signatures)
+	SignerEmailAddress string
+	// PreviousEmailAddress is the signer's email address before a reassignment
+	PreviousEmailAddress string
+	// OldStatus is the signer's status in the earlier snapshot
+	OldStatus SignerStatus
+	// NewStatus is the signer's status in the later snapshot
+	NewStatus SignerStatus
+}
+
+// DiffSignatureRequests compares two snapshots of the same signature request
+// and returns the changes between them.
+//
+// Signatures are matched by SignatureID, so a reassigned signature is reported
+// as SignatureChangeTypeReassigned rather than as a removal and an addition.
+// A reassignment that also changes the status produces two changes. A nil
+// before snapshot is treated as empty, reporting every signature as added.
+//
+// Changes are returned in the order of the later snapshot's signatures, followed
+// by removed signatures and finally a completion change, if any.
+//
+// Example:
+//
+//	for _, change := range dropboxsign.DiffSignatureRequests(previous, current) {
+//		if change.Type == dropboxsign.SignatureChangeTypeSigned {
+//			notifySigned(change.SignerEmailAddress)
+//		}
+//	}
+func DiffSignatureRequests(before, after *SignatureRequestResponse) []SignatureChange {
+	if before == nil {
+		before = &SignatureRequestResponse{}
+	}
+	if after == nil {
+		after = &SignatureRequestResponse{}
+	}
+
+	beforeSignatures := make(map[string]SignatureRequestResponseSignatures, len(before.Signatures))
+	for _, signature := range before.Signatures {
+		beforeSignatures[signature.SignatureID] = signature
+	}
+
+	var changes []SignatureChange
+	seen := make(map[string]bool, len(after.Signatures))
+
+	for _, signature := range after.Signatures {
+		seen[signature.SignatureID] = true
+		newStatus := ParseSignerStatus(signature.StatusCode)
+
+		previous, ok := beforeSignatures[signature.SignatureID]
+		if !ok {
+			changes = append(changes, SignatureChange{
+				Type:               SignatureChangeTypeAdded,
+				SignatureID:        signature.SignatureID,
+				SignerEmailAddress: signature.SignerEmailAddress,
+				NewStatus:          newStatus,
+			})
+			continue
+		}
+
+		oldStatus := ParseSignerStatus(previous.StatusCode)
+
+		if previous.SignerEmailAddress != signature.SignerEmailAddress {
+			changes = append(changes, SignatureChange{
+				Type:                 SignatureChangeTypeReassigned,
+				SignatureID:          signature.SignatureID,
+				SignerEmailAddress:   signature.SignerEmailAddress,
+				PreviousEmailAddress: previous.SignerEmailAddress,
+				OldStatus:            oldStatus,
+				NewStatus:            newStatus,
+			})
+		}
+
+		if oldStatus == newStatus {
+			continue
+		}
+
+		changeType := SignatureChangeTypeStatusChanged
+		switch newStatus {
+		case SignerStatusSigned:
+			changeType = SignatureChangeTypeSigned
+		case SignerStatusDeclined:
+			changeType = SignatureChangeTypeDeclined
+		}
+
+		changes = append(changes, SignatureChange{
+			Type:               changeType,
+			SignatureID:        signature.SignatureID,
+			SignerEmailAddress: signature.SignerEmailAddress,
+			OldStatus:          oldStatus,
+			NewStatus:          newStatus,
+		})
+	}
+
+	for _, signature := range before.Signatures {
+		if seen[signature.SignatureID] {
+			continue
+		}
+		changes = append(changes, SignatureChange{
+			Type:               SignatureChangeTypeRemoved,
+			SignatureID:        signature.SignatureID,
+			SignerEmailAddress: signature.SignerEmailAddress,
+			OldStatus:          ParseSignerStatus(signature.StatusCode),
+		})
+	}
+
+	if !before.IsComplete && after.IsComplete {
+		changes = append(changes, SignatureChange{Type: SignatureChangeTypeCompleted})
+	}
+
+	return changes
+}
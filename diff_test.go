@@ -0,0 +1,79 @@
+package dropboxsign
+
+import "testing"
+
+func TestDiffSignatureRequests(t *testing.T) {
+	old := &SignatureRequestResponse{
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "a@example.com", StatusCode: "awaiting_signature"},
+			{SignatureID: "sig-2", SignerEmailAddress: "b@example.com", StatusCode: "awaiting_signature"},
+			{SignatureID: "sig-3", SignerEmailAddress: "c@example.com", StatusCode: "awaiting_signature"},
+		},
+	}
+	new := &SignatureRequestResponse{
+		IsComplete: true,
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "a@example.com", StatusCode: "signed"},
+			{SignatureID: "sig-2", SignerEmailAddress: "delegate@example.com", StatusCode: "signed"},
+			{SignatureID: "sig-3", SignerEmailAddress: "c@example.com", StatusCode: "awaiting_signature"},
+		},
+	}
+
+	changes := DiffSignatureRequests(old, new)
+
+	expected := []SignatureChange{
+		{Type: SignatureChangeTypeSigned, SignatureID: "sig-1", SignerEmailAddress: "a@example.com", OldStatus: SignerStatusAwaitingSignature, NewStatus: SignerStatusSigned},
+		{Type: SignatureChangeTypeReassigned, SignatureID: "sig-2", SignerEmailAddress: "delegate@example.com", PreviousEmailAddress: "b@example.com", OldStatus: SignerStatusAwaitingSignature, NewStatus: SignerStatusSigned},
+		{Type: SignatureChangeTypeSigned, SignatureID: "sig-2", SignerEmailAddress: "delegate@example.com", OldStatus: SignerStatusAwaitingSignature, NewStatus: SignerStatusSigned},
+		{Type: SignatureChangeTypeCompleted},
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d: %+v", len(expected), len(changes), changes)
+	}
+
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Errorf("change %d: expected %+v, got %+v", i, expected[i], changes[i])
+		}
+	}
+}
+
+func TestDiffSignatureRequests_AddedAndRemoved(t *testing.T) {
+	old := &SignatureRequestResponse{
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "a@example.com", StatusCode: "awaiting_signature"},
+		},
+	}
+	new := &SignatureRequestResponse{
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-2", SignerEmailAddress: "b@example.com", StatusCode: "declined"},
+		},
+	}
+
+	changes := DiffSignatureRequests(old, new)
+
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d: %+v", len(changes), changes)
+	}
+
+	if changes[0].Type != SignatureChangeTypeAdded || changes[0].NewStatus != SignerStatusDeclined {
+		t.Errorf("expected added change with declined status, got %+v", changes[0])
+	}
+
+	if changes[1].Type != SignatureChangeTypeRemoved || changes[1].SignatureID != "sig-1" {
+		t.Errorf("expected removed change for sig-1, got %+v", changes[1])
+	}
+}
+
+func TestDiffSignatureRequests_NoChanges(t *testing.T) {
+	snapshot := &SignatureRequestResponse{
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "a@example.com", StatusCode: "awaiting_signature"},
+		},
+	}
+
+	if changes := DiffSignatureRequests(snapshot, snapshot); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+}
@@ -0,0 +1,310 @@
+package dropboxsign
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultDownloadPollInterval is how often DownloadFilesWhenReady checks
+// whether the files of a signature request are ready, unless
+// DownloadOptions.PollInterval is set.
+const DefaultDownloadPollInterval = 5 * time.Second
+
+// FileType is the format in which the files of a signature request are downloaded.
+type FileType string
+
+const (
+	// FileTypePDF downloads the documents merged into a single PDF
+	FileTypePDF FileType = "pdf"
+	// FileTypeZIP downloads a ZIP archive with one PDF per document
+	FileTypeZIP FileType = "zip"
+)
+
+// DownloadOptions represents optional parameters for downloading the files
+// of a signature request.
+//
+// There is no option for the audit trail: the API always appends it, with the
+// signers' IP addresses and timestamps, to the downloaded PDF once the
+// request is complete, and has no way to download it on its own. Nor is there
+// an option for flattening: the API always returns the signed copy with its
+// form fields flattened into the PDF, and has no parameter to change that.
+type DownloadOptions struct {
+	// FileType is the format of the download; the API default (PDF) is used when empty
+	FileType FileType
+	// PollInterval is how often DownloadFilesWhenReady checks whether the
+	// files are ready; DefaultDownloadPollInterval is used when zero
+	PollInterval time.Duration
+	// Ready, when set, makes DownloadFilesWhenReady wait for it to be closed
+	// or receive a value, such as from the handler of a
+	// signature_request_all_signed callback, instead of polling the
+	// signature request
+	Ready <-chan struct{}
+}
+
+// withDefaults returns the options with unset fields taken from defaults.
+// Ready is never taken from defaults, since a channel belongs to one download.
+func (o DownloadOptions) withDefaults(defaults DownloadOptions) DownloadOptions {
+	if o.FileType == "" {
+		o.FileType = defaults.FileType
+	}
+	if o.PollInterval == 0 {
+		o.PollInterval = defaults.PollInterval
+	}
+	return o
+}
+
+// WithDefaultDownloadOptions sets the options every download starts from.
+//
+// Fields left unset in the options passed to DownloadFilesTo or
+// DownloadFilesWhenReady are taken from defaults, so a per-call FileType or
+// PollInterval overrides the default. Ready is never inherited. The API has
+// no options for flattening or the audit trail (see DownloadOptions).
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").
+//		WithDefaultDownloadOptions(dropboxsign.DownloadOptions{FileType: dropboxsign.FileTypePDF})
+func (c *Client) WithDefaultDownloadOptions(defaults DownloadOptions) *Client {
+	c.defaultDownloadOptions = defaults
+	return c
+}
+
+// values encodes the options as URL query parameters.
+func (o DownloadOptions) values() url.Values {
+	values := url.Values{}
+	if o.FileType != "" {
+		values.Set("file_type", string(o.FileType))
+	}
+	return values
+}
+
+// DownloadFilesTo streams the files of a signature request to w, without
+// holding them in memory, and returns their content type.
+//
+// w can be an *os.File or an http.ResponseWriter, so a proxy can serve the
+// documents as they arrive. The files are not ready immediately after the
+// last signature; until they are, the API responds with a 409 Conflict error.
+// If copying to w fails part-way, w may have received part of the files.
+// While the client is recording (see WithRecorder), the files are held in
+// memory, since each response is recorded whole before it is returned.
+//
+// Example:
+//
+//	f, err := os.Create("contract.pdf")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	opts := dropboxsign.DownloadOptions{FileType: dropboxsign.FileTypePDF}
+//	if _, err := client.DownloadFilesTo(ctx, "signature_request_id", f, opts); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) DownloadFilesTo(ctx context.Context, signatureRequestID string, w io.Writer, opts DownloadOptions) (string, error) {
+	resp, err := c.openFiles(ctx, signatureRequestID, opts)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return "", NewClientError("failed to copy files", resp.StatusCode, err)
+	}
+
+	return resp.Header.Get("Content-Type"), nil
+}
+
+// DownloadFiles opens the files of a signature request for reading and
+// returns them along with their content type, for callers that want to
+// stream the files themselves rather than copy them to a writer.
+//
+// The caller must close the returned reader. An empty fileType uses the
+// client's default download options (see WithDefaultDownloadOptions). The
+// files are not ready immediately after the last signature; until they are,
+// the API responds with a 409 Conflict error. While the client is recording
+// (see WithRecorder), the files are read into memory before they are
+// returned.
+//
+// Example:
+//
+//	files, contentType, err := client.DownloadFiles(ctx, "signature_request_id", dropboxsign.FileTypeZIP)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer files.Close()
+//
+//	archive, err := os.Create("contract.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer archive.Close()
+//	if _, err := io.Copy(archive, files); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) DownloadFiles(ctx context.Context, signatureRequestID string, fileType FileType) (io.ReadCloser, string, error) {
+	resp, err := c.openFiles(ctx, signatureRequestID, DownloadOptions{FileType: fileType})
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+// fileDataURIResponse is the response of the files endpoint when a data URI
+// is requested.
+type fileDataURIResponse struct {
+	DataURI string `json:"data_uri"`
+}
+
+// DownloadFilesAsDataURI returns the files of a signature request merged into
+// a single PDF, encoded as a base64 data URI such as
+// "data:application/pdf;base64,JVBERi0x...", for embedding directly in a web
+// page.
+//
+// The whole PDF is held in memory, and grows by a third when encoded; use
+// DownloadFiles or DownloadFilesTo for large documents. The API only returns
+// PDFs as data URIs.
+//
+// Example:
+//
+//	dataURI, _, err := client.DownloadFilesAsDataURI(ctx, "signature_request_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Fprintf(w, `<iframe src="%s"></iframe>`, dataURI)
+func (c *Client) DownloadFilesAsDataURI(ctx context.Context, signatureRequestID string) (string, []WarningResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/signature_request/files/"+signatureRequestID+"?get_data_uri=true", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	response, warnings, err := parseListResponse[fileDataURIResponse](c.marshaler, body)
+	if err != nil {
+		return "", nil, NewClientError("failed to parse response", statusCode, err)
+	}
+	if response.DataURI == "" {
+		return "", nil, NewClientError("failed to parse response", statusCode, fmt.Errorf("missing key 'data_uri' in response"))
+	}
+
+	return response.DataURI, warnings, nil
+}
+
+// openFiles requests the files of a signature request and returns the
+// response with its body unread.
+func (c *Client) openFiles(ctx context.Context, signatureRequestID string, opts DownloadOptions) (*http.Response, error) {
+	opts = opts.withDefaults(c.defaultDownloadOptions)
+	path := "/signature_request/files/" + signatureRequestID
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doStream(req)
+}
+
+// DownloadFilesWhenReady waits until the signature request is complete and
+// its files have been generated, then streams them to w like DownloadFilesTo
+// and returns their content type.
+//
+// Unless opts.Ready is set, it polls GetSignatureRequest every
+// opts.PollInterval until the request is complete. It then retries the
+// download while the API responds with 409 Conflict because the files are
+// still being generated. Rate limit errors are waited out (see
+// WaitForRateLimitReset). It returns an error without downloading if the
+// request is declined, errored or expires, and gives up when ctx is done.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+//	defer cancel()
+//
+//	opts := dropboxsign.DownloadOptions{FileType: dropboxsign.FileTypePDF}
+//	if _, err := client.DownloadFilesWhenReady(ctx, "signature_request_id", f, opts); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) DownloadFilesWhenReady(ctx context.Context, signatureRequestID string, w io.Writer, opts DownloadOptions) (string, error) {
+	opts = opts.withDefaults(c.defaultDownloadOptions)
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultDownloadPollInterval
+	}
+
+	if opts.Ready != nil {
+		select {
+		case <-ctx.Done():
+			return "", NewClientError("gave up waiting for files", 0, ctx.Err())
+		case <-opts.Ready:
+		}
+	} else if err := c.waitUntilComplete(ctx, signatureRequestID, interval); err != nil {
+		return "", err
+	}
+
+	for {
+		contentType, err := c.DownloadFilesTo(ctx, signatureRequestID, w, opts)
+		if err == nil {
+			return contentType, nil
+		}
+		if WaitForRateLimitReset(ctx, err) {
+			continue
+		}
+		if errorStatus(err) != http.StatusConflict {
+			return "", err
+		}
+
+		if err := waitInterval(ctx, interval); err != nil {
+			return "", err
+		}
+	}
+}
+
+// waitUntilComplete polls the signature request every interval until it is
+// complete, returning an error if it can no longer be completed.
+func (c *Client) waitUntilComplete(ctx context.Context, signatureRequestID string, interval time.Duration) error {
+	for {
+		sigRequest, _, err := c.GetSignatureRequest(ctx, signatureRequestID)
+		if err != nil {
+			if WaitForRateLimitReset(ctx, err) {
+				continue
+			}
+			return err
+		}
+
+		switch state := sigRequest.State(); state {
+		case RequestStateCompleted:
+			return nil
+		case RequestStateDeclined, RequestStateErrored, RequestStateExpired:
+			return NewClientError(fmt.Sprintf("signature request %s is %s and has no signed files", signatureRequestID, state), 0, nil)
+		}
+
+		if err := waitInterval(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// waitInterval blocks for d, returning an error if ctx is done first.
+func waitInterval(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return NewClientError("gave up waiting for files", 0, ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,267 @@
+package dropboxsign
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDownloadFilesTo(t *testing.T) {
+	pdf := []byte("%PDF-1.4 signed contract")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/files/abc123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if fileType := r.URL.Query().Get("file_type"); fileType != "pdf" {
+			t.Errorf("expected file_type pdf, got %q", fileType)
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		if _, err := w.Write(pdf); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithLogger(logger)
+
+	var buf bytes.Buffer
+	contentType, err := client.DownloadFilesTo(context.Background(), "abc123", &buf, DownloadOptions{FileType: FileTypePDF})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if contentType != "application/pdf" {
+		t.Errorf("expected content type application/pdf, got %s", contentType)
+	}
+	if !bytes.Equal(buf.Bytes(), pdf) {
+		t.Errorf("expected %q, got %q", pdf, buf.Bytes())
+	}
+	if len(logger.infos) != 1 || logger.infos[0].StatusCode != http.StatusOK {
+		t.Errorf("expected one logged request, got %+v", logger.infos)
+	}
+}
+
+func TestDownloadFiles(t *testing.T) {
+	archive := []byte("PK\x03\x04 signed contract")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/files/abc123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if fileType := r.URL.Query().Get("file_type"); fileType != "zip" {
+			t.Errorf("expected file_type zip, got %q", fileType)
+		}
+
+		w.Header().Set("Content-Type", "application/zip")
+		if _, err := w.Write(archive); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	files, contentType, err := client.DownloadFiles(context.Background(), "abc123", FileTypeZIP)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer files.Close()
+
+	data, err := io.ReadAll(files)
+	if err != nil {
+		t.Fatalf("failed to read files: %v", err)
+	}
+	if contentType != "application/zip" || !bytes.Equal(data, archive) {
+		t.Errorf("expected the ZIP archive, got %s %q", contentType, data)
+	}
+}
+
+func TestDownloadFilesAsDataURI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/files/abc123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if getDataURI := r.URL.Query().Get("get_data_uri"); getDataURI != "true" {
+			t.Errorf("expected get_data_uri true, got %q", getDataURI)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"data_uri": "data:application/pdf;base64,JVBERi0xLjQ="}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	dataURI, _, err := client.DownloadFilesAsDataURI(context.Background(), "abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dataURI != "data:application/pdf;base64,JVBERi0xLjQ=" {
+		t.Errorf("expected the data URI, got %q", dataURI)
+	}
+}
+
+func TestDownloadFilesTo_NotReady(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		if _, err := w.Write([]byte(`{"error": {"error_msg": "Files are still being processed", "error_name": "conflict"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	var buf bytes.Buffer
+	_, err := client.DownloadFilesTo(context.Background(), "abc123", &buf, DownloadOptions{})
+
+	apiErr, ok := err.(ErrorResponseError)
+	if !ok || apiErr.Status != http.StatusConflict {
+		t.Fatalf("expected 409 ErrorResponseError, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got %q", buf.Bytes())
+	}
+}
+
+func TestDownloadFilesWhenReady(t *testing.T) {
+	pdf := []byte("%PDF-1.4 signed contract")
+	var statusChecks, downloads int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/signature_request/abc123":
+			statusChecks++
+			w.Header().Set("Content-Type", "application/json")
+			body := `{"signature_request": {"signature_request_id": "abc123", "is_complete": false}}`
+			if statusChecks > 1 {
+				body = `{"signature_request": {"signature_request_id": "abc123", "is_complete": true}}`
+			}
+			if _, err := w.Write([]byte(body)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		case "/v3/signature_request/files/abc123":
+			downloads++
+			if downloads == 1 {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusConflict)
+				if _, err := w.Write([]byte(`{"error": {"error_msg": "Files are still being processed", "error_name": "conflict"}}`)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+				return
+			}
+			w.Header().Set("Content-Type", "application/pdf")
+			if _, err := w.Write(pdf); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	var buf bytes.Buffer
+	contentType, err := client.DownloadFilesWhenReady(context.Background(), "abc123", &buf, DownloadOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if contentType != "application/pdf" || !bytes.Equal(buf.Bytes(), pdf) {
+		t.Errorf("expected the PDF, got %s %q", contentType, buf.Bytes())
+	}
+	if statusChecks != 2 || downloads != 2 {
+		t.Errorf("expected 2 status checks and 2 downloads, got %d and %d", statusChecks, downloads)
+	}
+}
+
+func TestDownloadFilesWhenReady_Declined(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/abc123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123", "is_declined": true}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	var buf bytes.Buffer
+	_, err := client.DownloadFilesWhenReady(context.Background(), "abc123", &buf, DownloadOptions{PollInterval: time.Millisecond})
+	if err == nil || !strings.Contains(err.Error(), "is declined") {
+		t.Errorf("expected declined error, got %v", err)
+	}
+}
+
+func TestDownloadFilesWhenReady_ReadyChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/files/abc123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/pdf")
+		if _, err := w.Write([]byte("%PDF-1.4")); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var buf bytes.Buffer
+	if _, err := client.DownloadFilesWhenReady(ctx, "abc123", &buf, DownloadOptions{Ready: make(chan struct{})}); err == nil {
+		t.Error("expected error when the context is done before the files are ready, got nil")
+	}
+
+	ready := make(chan struct{})
+	close(ready)
+	if _, err := client.DownloadFilesWhenReady(context.Background(), "abc123", &buf, DownloadOptions{Ready: ready}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "%PDF-1.4" {
+		t.Errorf("expected the PDF, got %q", buf.String())
+	}
+}
+
+func TestWithDefaultDownloadOptions(t *testing.T) {
+	var fileTypes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fileTypes = append(fileTypes, r.URL.Query().Get("file_type"))
+		w.Header().Set("Content-Type", "application/zip")
+		if _, err := w.Write([]byte("PK")); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").
+		WithDefaultDownloadOptions(DownloadOptions{FileType: FileTypeZIP})
+
+	var buf bytes.Buffer
+	if _, err := client.DownloadFilesTo(context.Background(), "abc123", &buf, DownloadOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.DownloadFilesTo(context.Background(), "abc123", &buf, DownloadOptions{FileType: FileTypePDF}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fileTypes) != 2 || fileTypes[0] != "zip" || fileTypes[1] != "pdf" {
+		t.Errorf("expected the default then the override, got %v", fileTypes)
+	}
+}
@@ -26,6 +26,8 @@
 package dropboxsign
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -33,12 +35,17 @@ import (
 // ResponseWithWarnings wraps API responses that may contain warnings alongside the main data.
 //
 // The Inner field contains the actual response data, while Warnings contains
-// any non-fatal warnings returned by the API.
+// any non-fatal warnings returned by the API. It is returned by the
+// *WithResponse client methods, which also set Response.
 type ResponseWithWarnings[T any] struct {
 	// Inner is the main response data
 	Inner T `json:"-"`
 	// Warnings contains optional warnings returned by the API
 	Warnings []WarningResponse `json:"warnings,omitempty"`
+	// Response is the HTTP response the data was parsed from, for inspecting
+	// headers such as Date, X-Request-Id or the rate limit headers. Its body
+	// has already been read; Response.Body replays it.
+	Response *http.Response `json:"-"`
 }
 
 // WarningResponse represents a non-fatal warning returned by the Dropbox Sign API.
@@ -57,16 +64,55 @@ func (w WarningResponse) String() string {
 	return fmt.Sprintf("%s (%s)", w.WarningMsg, w.WarningName)
 }
 
+// ListInfo contains pagination information returned by list endpoints.
+type ListInfo struct {
+	// NumPages is the total number of pages available
+	NumPages int `json:"num_pages"`
+	// NumResults is the total number of objects available
+	NumResults *int `json:"num_results,omitempty"`
+	// Page is the number of the current page (1-based)
+	Page int `json:"page"`
+	// PageSize is the number of objects per page
+	PageSize int `json:"page_size"`
+	// NextPageURL is the URL of the next page, when returned by the API
+	NextPageURL *string `json:"next_page_url,omitempty"`
+}
+
+// HasNextPage reports whether another page of results is available.
+//
+// A next page URL returned by the API takes precedence; otherwise the
+// current page is compared against the total number of pages.
+func (l *ListInfo) HasNextPage() bool {
+	if l.NextPageURL != nil && *l.NextPageURL != "" {
+		return true
+	}
+	return l.Page < l.NumPages
+}
+
+// NextPage returns the number of the page following the current one.
+//
+// Only meaningful when HasNextPage returns true.
+func (l *ListInfo) NextPage() int {
+	return l.Page + 1
+}
+
 // ErrorResponse is the top-level error response structure from the Dropbox Sign API.
+//
+// Most endpoints return a single error object; some return a list under
+// "errors" instead, in which case the first entry is used.
 type ErrorResponse struct {
 	// Error contains the detailed error information
 	Error ErrorResponseError `json:"error"`
+	// Errors contains the error list returned by endpoints using the list shape
+	Errors []ErrorResponseError `json:"errors,omitempty"`
 }
 
 // ErrorResponseError contains detailed error information from the Dropbox Sign API.
 //
 // Contains structured error details including HTTP status codes,
 // error messages, and optional path information for field-specific errors.
+// The type is comparable, so errors can be checked with == and switch; data
+// that cannot be compared, such as the raw body, is kept in Details.
 type ErrorResponseError struct {
 	// Status is the HTTP status code
 	Status int `json:"-"`
@@ -76,6 +122,22 @@ type ErrorResponseError struct {
 	ErrorPath *string `json:"error_path,omitempty"`
 	// ErrorName is the machine-readable error identifier
 	ErrorName string `json:"error_name"`
+	// Details holds the response body and warnings the error was parsed
+	// from, or nil for errors not built from an API response
+	Details *ErrorDetails `json:"-"`
+	// RetryCount is the number of times the request was retried before
+	// failing (see WithRetry)
+	RetryCount int `json:"-"`
+}
+
+// ErrorDetails contains the parts of an API error response that are not
+// comparable, kept behind a pointer in ErrorResponseError.
+type ErrorDetails struct {
+	// Raw is the complete response body the error was parsed from
+	Raw json.RawMessage
+	// Warnings contains any warnings returned alongside the error, which can
+	// explain it
+	Warnings []WarningResponse
 }
 
 // Error implements the error interface for ErrorResponseError.
@@ -94,6 +156,12 @@ type ClientError struct {
 	StatusCode int
 	// Err is the underlying error (if any)
 	Err error
+	// RetryCount is the number of times the request was retried before
+	// failing (see WithRetry and WithMaxNetworkRetries)
+	RetryCount int
+
+	// retryable records whether the failed request can safely be sent again
+	retryable bool
 }
 
 // Error implements the error interface for ClientError.
@@ -123,33 +191,37 @@ func NewClientError(message string, statusCode int, err error) *ClientError {
 
 // IsNotFound returns true if the error is a 404 Not Found error.
 func IsNotFound(err error) bool {
-	if apiErr, ok := err.(ErrorResponseError); ok {
-		return apiErr.Status == http.StatusNotFound
-	}
-	if clientErr, ok := err.(*ClientError); ok {
-		return clientErr.StatusCode == http.StatusNotFound
-	}
-	return false
+	return errorStatus(err) == http.StatusNotFound
 }
 
 // IsBadRequest returns true if the error is a 400 Bad Request error.
 func IsBadRequest(err error) bool {
-	if apiErr, ok := err.(ErrorResponseError); ok {
-		return apiErr.Status == http.StatusBadRequest
-	}
-	if clientErr, ok := err.(*ClientError); ok {
-		return clientErr.StatusCode == http.StatusBadRequest
-	}
-	return false
+	return errorStatus(err) == http.StatusBadRequest
 }
 
 // IsUnauthorized returns true if the error is a 401 Unauthorized error.
 func IsUnauthorized(err error) bool {
-	if apiErr, ok := err.(ErrorResponseError); ok {
-		return apiErr.Status == http.StatusUnauthorized
+	return errorStatus(err) == http.StatusUnauthorized
+}
+
+// IsRateLimited returns true if the error is a 429 Too Many Requests error.
+func IsRateLimited(err error) bool {
+	return errorStatus(err) == http.StatusTooManyRequests
+}
+
+// errorStatus returns the HTTP status code of the first API error
+// (ErrorResponseError, including one embedded in a RateLimitError) or
+// *ClientError in err's chain, or 0 if there is none. Using errors.As keeps
+// the Is* helpers working for errors wrapped with fmt.Errorf("%w") or by
+// errors that embed an API error.
+func errorStatus(err error) int {
+	var apiErr ErrorResponseError
+	if errors.As(err, &apiErr) {
+		return apiErr.Status
 	}
-	if clientErr, ok := err.(*ClientError); ok {
-		return clientErr.StatusCode == http.StatusUnauthorized
+	var clientErr *ClientError
+	if errors.As(err, &clientErr) {
+		return clientErr.StatusCode
 	}
-	return false
+	return 0
 }
@@ -0,0 +1,19 @@
+package dropboxsign
+
+import "time"
+
+// EmbeddedResponse contains an embedded signing URL for a single signer.
+//
+// Embedded signing URLs are short-lived and can only be used once; fetch a
+// new one each time the signer opens the signing page.
+type EmbeddedResponse struct {
+	// SignURL is the URL to load in the embedded signing iFrame
+	SignURL string `json:"sign_url"`
+	// ExpiresAt is the Unix timestamp when the sign URL expires
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// ExpiresAtTime returns ExpiresAt as a time.Time.
+func (e *EmbeddedResponse) ExpiresAtTime() time.Time {
+	return time.Unix(e.ExpiresAt, 0)
+}
@@ -0,0 +1,39 @@
+package dropboxsign
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetEmbeddedSignURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/v3/embedded/sign_url/sig-1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"embedded": {"sign_url": "https://app.hellosign.com/editor/embeddedSign?signature_id=sig-1", "expires_at": 1700000300}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	embedded, _, err := client.GetEmbeddedSignURL(context.Background(), "sig-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if embedded.SignURL != "https://app.hellosign.com/editor/embeddedSign?signature_id=sig-1" {
+		t.Errorf("unexpected sign URL: %s", embedded.SignURL)
+	}
+	if expiresAt := embedded.ExpiresAtTime(); !expiresAt.Equal(time.Unix(1700000300, 0)) {
+		t.Errorf("expected expires at %v, got %v", time.Unix(1700000300, 0), expiresAt)
+	}
+}
@@ -0,0 +1,318 @@
+// Package envelope tracks a Dropbox Sign signature request through its
+// lifecycle and reports each state transition.
+//
+// An Envelope is fed callback events with ObserveEvent, or snapshots of a
+// signature request by polling with Poll. Each snapshot, including the
+// signature_request object carried by events, is compared with the previous
+// one using dropboxsign.DiffSignatureRequests, and registered callbacks are
+// invoked whenever the envelope moves to a later state.
+//
+// Example:
+//
+//	env := envelope.New().OnTransition(func(t envelope.Transition) {
+//		log.Printf("%s: %s -> %s", t.SignatureRequest.SignatureRequestID, t.From, t.To)
+//	})
+//
+//	sigRequest, _, err := client.SendWithTemplate(ctx, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	env.Observe(sigRequest)
+//
+//	if err := env.Poll(ctx, client, sigRequest.SignatureRequestID, time.Minute); err != nil {
+//		log.Fatal(err)
+//	}
+package envelope
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	dropboxsign "github.com/cjcox17/dropbox-sign-go"
+)
+
+// State is a stage in the lifecycle of a signature request.
+type State string
+
+const (
+	// StateCreated means no snapshot of the signature request has been observed yet
+	StateCreated State = "created"
+	// StateSent means the signature request exists and is awaiting its signers
+	StateSent State = "sent"
+	// StateViewed means at least one signer has viewed the signature request
+	StateViewed State = "viewed"
+	// StateSigned means every signer has signed and the request is complete
+	StateSigned State = "signed"
+	// StateDeclined means a signer declined; no further transitions occur
+	StateDeclined State = "declined"
+	// StateExpired means the request expired before it was complete; no further transitions occur
+	StateExpired State = "expired"
+	// StateErrored means the request could not be processed; no further transitions occur
+	StateErrored State = "errored"
+	// StateCanceled means the requester canceled the request, as reported by a
+	// signature_request_canceled event; no further transitions occur
+	StateCanceled State = "canceled"
+	// StateDownloaded means the signed documents were downloaded, as reported by MarkDownloaded
+	StateDownloaded State = "downloaded"
+)
+
+// rank orders the states so that the envelope only ever moves forward.
+var rank = map[State]int{
+	StateCreated:    0,
+	StateSent:       1,
+	StateViewed:     2,
+	StateSigned:     3,
+	StateDownloaded: 4,
+	StateDeclined:   5,
+	StateExpired:    5,
+	StateErrored:    5,
+	StateCanceled:   5,
+}
+
+// IsTerminal reports whether no further transitions are expected from s.
+//
+// StateSigned is not terminal, since it can still move to StateDownloaded.
+func (s State) IsTerminal() bool {
+	switch s {
+	case StateDeclined, StateExpired, StateErrored, StateCanceled, StateDownloaded:
+		return true
+	}
+	return false
+}
+
+// Transition describes a move of an envelope from one state to a later one.
+type Transition struct {
+	// From is the state before the transition
+	From State
+	// To is the state after the transition
+	To State
+	// SignatureRequest is the snapshot that caused the transition
+	SignatureRequest *dropboxsign.SignatureRequestResponse
+	// Changes are the signature changes between the previous snapshot and this one
+	Changes []dropboxsign.SignatureChange
+}
+
+// Envelope tracks the state of a single signature request.
+//
+// It is safe for concurrent use. Callbacks are invoked synchronously, in
+// registration order, by the call that caused the transition, without any
+// lock held.
+type Envelope struct {
+	mu           sync.Mutex
+	state        State
+	last         *dropboxsign.SignatureRequestResponse
+	downloadable bool
+	onTransition []func(Transition)
+}
+
+// New creates an envelope in StateCreated.
+func New() *Envelope {
+	return &Envelope{state: StateCreated}
+}
+
+// OnTransition registers fn to be called on every state transition.
+//
+// Returns the envelope for method chaining.
+func (e *Envelope) OnTransition(fn func(Transition)) *Envelope {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onTransition = append(e.onTransition, fn)
+	return e
+}
+
+// State returns the current state of the envelope.
+func (e *Envelope) State() State {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state
+}
+
+// SignatureRequest returns the most recently observed snapshot, or nil if
+// none has been observed.
+func (e *Envelope) SignatureRequest() *dropboxsign.SignatureRequestResponse {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.last
+}
+
+// Observe records a new snapshot of the signature request, such as the one
+// carried by a callback event or returned by GetSignatureRequest.
+//
+// It returns the signature changes since the previous snapshot. If the
+// snapshot moves the envelope to a later state, the transition callbacks are
+// called. Snapshots that rank behind the current state, such as a stale event
+// delivered out of order, are ignored: they return no changes and are not
+// kept as the previous snapshot, so later snapshots are not diffed against
+// them.
+func (e *Envelope) Observe(sigRequest *dropboxsign.SignatureRequestResponse) []dropboxsign.SignatureChange {
+	e.mu.Lock()
+	next := stateOf(sigRequest)
+	if rank[next] < rank[e.state] {
+		e.mu.Unlock()
+		return nil
+	}
+
+	changes := dropboxsign.DiffSignatureRequests(e.last, sigRequest)
+	e.last = sigRequest
+
+	transition, ok := e.advance(next)
+	transition.SignatureRequest = sigRequest
+	transition.Changes = changes
+	callbacks := e.onTransition
+	e.mu.Unlock()
+
+	if ok {
+		for _, fn := range callbacks {
+			fn(transition)
+		}
+	}
+	return changes
+}
+
+// ObserveEvent records a callback event for the signature request.
+//
+// The signature_request object carried by the event, if any, is observed as
+// a snapshot (see Observe), and its changes are returned. Events that the
+// snapshot cannot express are applied as well: signature_request_canceled
+// moves the envelope to StateCanceled, signature_request_invalid to
+// StateErrored, and signature_request_downloadable marks the signed files as
+// ready to download (see Downloadable).
+//
+// Example:
+//
+//	event, err := dropboxsign.VerifyEventRequest(r, apiKey)
+//	if err != nil {
+//		http.Error(w, "invalid event", http.StatusBadRequest)
+//		return
+//	}
+//	env.ObserveEvent(event)
+func (e *Envelope) ObserveEvent(event *dropboxsign.Event) []dropboxsign.SignatureChange {
+	if event == nil {
+		return nil
+	}
+
+	var changes []dropboxsign.SignatureChange
+	if event.SignatureRequest != nil {
+		changes = e.Observe(event.SignatureRequest)
+	}
+
+	switch event.Event.EventType {
+	case dropboxsign.EventTypeSignatureRequestCanceled:
+		e.moveTo(StateCanceled)
+	case dropboxsign.EventTypeSignatureRequestInvalid:
+		e.moveTo(StateErrored)
+	case dropboxsign.EventTypeSignatureRequestDownloadable:
+		e.mu.Lock()
+		e.downloadable = true
+		e.mu.Unlock()
+	}
+	return changes
+}
+
+// Downloadable reports whether a signature_request_downloadable event has
+// been observed, meaning the signed files are ready to download.
+func (e *Envelope) Downloadable() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.downloadable
+}
+
+// MarkDownloaded moves a signed envelope to StateDownloaded.
+//
+// The API does not report downloads, so call this once the signed documents
+// have been stored. It has no effect unless the envelope is in StateSigned.
+func (e *Envelope) MarkDownloaded() {
+	e.mu.Lock()
+	signed := e.state == StateSigned
+	e.mu.Unlock()
+	if signed {
+		e.moveTo(StateDownloaded)
+	}
+}
+
+// moveTo advances the envelope to next, if it is later than the current
+// state, and calls the transition callbacks.
+func (e *Envelope) moveTo(next State) {
+	e.mu.Lock()
+	transition, ok := e.advance(next)
+	transition.SignatureRequest = e.last
+	callbacks := e.onTransition
+	e.mu.Unlock()
+
+	if ok {
+		for _, fn := range callbacks {
+			fn(transition)
+		}
+	}
+}
+
+// Poll fetches the signature request every interval and observes it, until
+// the envelope reaches StateSigned or a terminal state, or ctx is done.
+//
+// The API does not report cancellation in the signature request, so a
+// canceled request is only recognized through a signature_request_canceled
+// event passed to ObserveEvent, such as from a callback handler running
+// alongside Poll. It returns nil once the envelope is signed, declined,
+// expired, errored or canceled, ctx.Err() if ctx is done first, and the
+// first error returned by GetSignatureRequest.
+func (e *Envelope) Poll(ctx context.Context, client dropboxsign.SignatureService, signatureRequestID string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sigRequest, _, err := client.GetSignatureRequest(ctx, signatureRequestID)
+		if err != nil {
+			return err
+		}
+		e.Observe(sigRequest)
+
+		if state := e.State(); state == StateSigned || state.IsTerminal() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if e.State().IsTerminal() {
+			return nil
+		}
+	}
+}
+
+// advance moves the envelope to next if it is later than the current state.
+// The caller must hold e.mu.
+func (e *Envelope) advance(next State) (Transition, bool) {
+	if e.state.IsTerminal() || rank[next] <= rank[e.state] {
+		return Transition{}, false
+	}
+	transition := Transition{From: e.state, To: next}
+	e.state = next
+	return transition, true
+}
+
+// stateOf derives the lifecycle state of a signature request snapshot.
+func stateOf(sigRequest *dropboxsign.SignatureRequestResponse) State {
+	if sigRequest == nil {
+		return StateCreated
+	}
+	switch sigRequest.State() {
+	case dropboxsign.RequestStateErrored:
+		return StateErrored
+	case dropboxsign.RequestStateDeclined:
+		return StateDeclined
+	case dropboxsign.RequestStateCompleted:
+		return StateSigned
+	case dropboxsign.RequestStateExpired:
+		return StateExpired
+	}
+	for _, signature := range sigRequest.Signatures {
+		if signature.HasViewed() {
+			return StateViewed
+		}
+	}
+	return StateSent
+}
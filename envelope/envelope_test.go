@@ -0,0 +1,186 @@
+package envelope
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	dropboxsign "github.com/cjcox17/dropbox-sign-go"
+	"github.com/cjcox17/dropbox-sign-go/fakeclient"
+)
+
+func snapshot(status string, viewed, complete bool) *dropboxsign.SignatureRequestResponse {
+	signature := dropboxsign.SignatureRequestResponseSignatures{
+		SignatureID:        "sig-1",
+		SignerEmailAddress: "john@example.com",
+		StatusCode:         status,
+	}
+	if viewed {
+		viewedAt := int64(1700000000)
+		signature.LastViewedAt = &viewedAt
+	}
+	return &dropboxsign.SignatureRequestResponse{
+		SignatureRequestID: "abc123",
+		IsComplete:         complete,
+		Signatures:         []dropboxsign.SignatureRequestResponseSignatures{signature},
+	}
+}
+
+func TestEnvelope_Lifecycle(t *testing.T) {
+	var transitions []State
+	env := New().OnTransition(func(tr Transition) {
+		transitions = append(transitions, tr.To)
+	})
+
+	env.Observe(snapshot("awaiting_signature", false, false))
+	env.Observe(snapshot("awaiting_signature", true, false))
+	// A stale snapshot delivered out of order must not move the state back.
+	env.Observe(snapshot("awaiting_signature", false, false))
+	changes := env.Observe(snapshot("signed", true, true))
+	env.MarkDownloaded()
+	env.MarkDownloaded()
+
+	expected := []State{StateSent, StateViewed, StateSigned, StateDownloaded}
+	if !reflect.DeepEqual(transitions, expected) {
+		t.Errorf("expected transitions %v, got %v", expected, transitions)
+	}
+
+	if len(changes) != 2 || changes[0].Type != dropboxsign.SignatureChangeTypeSigned || changes[1].Type != dropboxsign.SignatureChangeTypeCompleted {
+		t.Errorf("expected signed and completed changes, got %+v", changes)
+	}
+
+	if env.State() != StateDownloaded {
+		t.Errorf("expected state %s, got %s", StateDownloaded, env.State())
+	}
+}
+
+func TestEnvelope_Declined(t *testing.T) {
+	env := New()
+
+	declined := snapshot("declined", true, false)
+	declined.IsDeclined = true
+	env.Observe(declined)
+	env.Observe(snapshot("signed", true, true))
+	env.MarkDownloaded()
+
+	if env.State() != StateDeclined {
+		t.Errorf("expected state %s, got %s", StateDeclined, env.State())
+	}
+}
+
+func TestEnvelope_Poll(t *testing.T) {
+	fake := fakeclient.New()
+	fake.AddSignatureRequest(snapshot("signed", true, true))
+
+	env := New()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := env.Poll(ctx, fake, "abc123", time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.State() != StateSigned {
+		t.Errorf("expected state %s, got %s", StateSigned, env.State())
+	}
+}
+
+func TestEnvelope_StaleSnapshotNotRediffed(t *testing.T) {
+	env := New()
+
+	if changes := env.Observe(snapshot("signed", true, true)); len(changes) != 2 {
+		t.Fatalf("expected added and completed changes, got %+v", changes)
+	}
+	if changes := env.Observe(snapshot("awaiting_signature", true, false)); len(changes) != 0 {
+		t.Errorf("expected a stale snapshot to report no changes, got %+v", changes)
+	}
+	if changes := env.Observe(snapshot("signed", true, true)); len(changes) != 0 {
+		t.Errorf("expected no repeated changes, got %+v", changes)
+	}
+	if last := env.SignatureRequest(); !last.IsComplete {
+		t.Errorf("expected the complete snapshot to be kept, got %+v", last)
+	}
+}
+
+func TestEnvelope_PollExpired(t *testing.T) {
+	fake := fakeclient.New()
+	fake.AddSignatureRequest(snapshot("expired", true, false))
+
+	env := New()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := env.Poll(ctx, fake, "abc123", time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.State() != StateExpired {
+		t.Errorf("expected state %s, got %s", StateExpired, env.State())
+	}
+}
+
+func TestEnvelope_ObserveEvent(t *testing.T) {
+	var transitions []State
+	env := New().OnTransition(func(tr Transition) {
+		transitions = append(transitions, tr.To)
+	})
+
+	changes := env.ObserveEvent(&dropboxsign.Event{
+		Event:            dropboxsign.EventDetails{EventType: dropboxsign.EventTypeSignatureRequestViewed},
+		SignatureRequest: snapshot("awaiting_signature", true, false),
+	})
+	if len(changes) != 1 || changes[0].Type != dropboxsign.SignatureChangeTypeAdded {
+		t.Errorf("expected the signature to be added, got %+v", changes)
+	}
+
+	env.ObserveEvent(&dropboxsign.Event{
+		Event:            dropboxsign.EventDetails{EventType: dropboxsign.EventTypeSignatureRequestCanceled},
+		SignatureRequest: snapshot("awaiting_signature", true, false),
+	})
+	env.ObserveEvent(nil)
+
+	expected := []State{StateViewed, StateCanceled}
+	if !reflect.DeepEqual(transitions, expected) {
+		t.Errorf("expected transitions %v, got %v", expected, transitions)
+	}
+	if !env.State().IsTerminal() {
+		t.Errorf("expected canceled to be terminal")
+	}
+}
+
+func TestEnvelope_ObserveEventDownloadable(t *testing.T) {
+	env := New()
+	if env.Downloadable() {
+		t.Fatal("expected a new envelope not to be downloadable")
+	}
+
+	env.ObserveEvent(&dropboxsign.Event{
+		Event:            dropboxsign.EventDetails{EventType: dropboxsign.EventTypeSignatureRequestDownloadable},
+		SignatureRequest: snapshot("signed", true, true),
+	})
+	if !env.Downloadable() || env.State() != StateSigned {
+		t.Errorf("expected a signed, downloadable envelope, got %s (downloadable %v)", env.State(), env.Downloadable())
+	}
+}
+
+func TestEnvelope_PollStopsOnCancel(t *testing.T) {
+	fake := fakeclient.New()
+	fake.AddSignatureRequest(snapshot("awaiting_signature", false, false))
+
+	env := New()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		env.ObserveEvent(&dropboxsign.Event{
+			Event: dropboxsign.EventDetails{EventType: dropboxsign.EventTypeSignatureRequestCanceled},
+		})
+	}()
+
+	if err := env.Poll(ctx, fake, "abc123", time.Millisecond); err != nil {
+		t.Fatalf("expected Poll to stop on cancel, got %v", err)
+	}
+	if env.State() != StateCanceled {
+		t.Errorf("expected state %s, got %s", StateCanceled, env.State())
+	}
+}
@@ -0,0 +1,238 @@
+package dropboxsign
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventReceivedResponse is the response body Dropbox Sign expects from a
+// callback URL. Until a callback is answered with it, the event is retried.
+const EventReceivedResponse = "Hello API Event Received"
+
+// EventType identifies the kind of callback event.
+type EventType string
+
+const (
+	// EventTypeCallbackTest is sent when a callback URL is tested
+	EventTypeCallbackTest EventType = "callback_test"
+	// EventTypeSignatureRequestSent is sent when a signature request is sent
+	EventTypeSignatureRequestSent EventType = "signature_request_sent"
+	// EventTypeSignatureRequestViewed is sent when a signer views the request
+	EventTypeSignatureRequestViewed EventType = "signature_request_viewed"
+	// EventTypeSignatureRequestSigned is sent when a signer signs the request
+	EventTypeSignatureRequestSigned EventType = "signature_request_signed"
+	// EventTypeSignatureRequestDeclined is sent when a signer declines the request
+	EventTypeSignatureRequestDeclined EventType = "signature_request_declined"
+	// EventTypeSignatureRequestAllSigned is sent when every signer has signed
+	EventTypeSignatureRequestAllSigned EventType = "signature_request_all_signed"
+	// EventTypeSignatureRequestDownloadable is sent when the signed files are ready to download
+	EventTypeSignatureRequestDownloadable EventType = "signature_request_downloadable"
+	// EventTypeSignatureRequestCanceled is sent when the request is canceled
+	EventTypeSignatureRequestCanceled EventType = "signature_request_canceled"
+	// EventTypeSignatureRequestInvalid is sent when the request could not be processed
+	EventTypeSignatureRequestInvalid EventType = "signature_request_invalid"
+)
+
+// Event is a callback event delivered by Dropbox Sign to a callback URL.
+//
+// The API has no endpoint that lists the events of a signature request, so
+// the only record of its timeline is the callbacks received for it. Store
+// events as they arrive, keyed by DedupKey, to answer questions such as when
+// a signer first viewed the request; the audit trail in the signed files
+// also records when it was sent, viewed and signed.
+type Event struct {
+	// Event contains the details of the event
+	Event EventDetails `json:"event"`
+	// SignatureRequest is the signature request the event relates to, if any
+	SignatureRequest *SignatureRequestResponse `json:"signature_request,omitempty"`
+	// Template is the template the event relates to, if any
+	Template *TemplateResponse `json:"template,omitempty"`
+	// AccountGUID is the ID of the account the event was sent for; only set
+	// on account callbacks
+	AccountGUID *string `json:"account_guid,omitempty"`
+	// ClientID is the client ID of the app the event was sent for; only set
+	// on app callbacks
+	ClientID *string `json:"client_id,omitempty"`
+}
+
+// ParseEvent decodes a callback event and verifies that it was sent by
+// Dropbox Sign, by checking its EventHash against an HMAC-SHA256 of the
+// event time and type keyed by apiKey.
+//
+// It returns an error if body is not a valid event or the hash does not
+// match, in which case the callback must not be trusted. The hashes are
+// compared in constant time. body is the value of the "json" form field of
+// the callback; see VerifyEventRequest to read it from the request.
+//
+// Example:
+//
+//	event, err := dropboxsign.ParseEvent([]byte(r.FormValue("json")), apiKey)
+//	if err != nil {
+//		http.Error(w, "invalid event", http.StatusBadRequest)
+//		return
+//	}
+func ParseEvent(body []byte, apiKey string) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, NewClientError("failed to parse event", 0, err)
+	}
+	if event.Event.EventHash == "" {
+		return nil, NewClientError("event has no event_hash", 0, nil)
+	}
+
+	mac := hmac.New(sha256.New, []byte(apiKey))
+	mac.Write([]byte(event.Event.EventTime + string(event.Event.EventType)))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(event.Event.EventHash))) {
+		return nil, NewClientError("event_hash does not match", 0, nil)
+	}
+
+	return &event, nil
+}
+
+// VerifyEventRequest reads a callback event from an HTTP request posted by
+// Dropbox Sign and verifies it with ParseEvent.
+//
+// Dropbox Sign posts the event as the "json" field of a form, which may be
+// multipart or URL-encoded. After processing the event, answer with
+// EventReceivedResponse so that it is not delivered again.
+//
+// Example:
+//
+//	http.HandleFunc("/callbacks/dropbox-sign", func(w http.ResponseWriter, r *http.Request) {
+//		event, err := dropboxsign.VerifyEventRequest(r, apiKey)
+//		if err != nil {
+//			http.Error(w, "invalid event", http.StatusBadRequest)
+//			return
+//		}
+//		handle(event)
+//		fmt.Fprint(w, dropboxsign.EventReceivedResponse)
+//	})
+func VerifyEventRequest(r *http.Request, apiKey string) (*Event, error) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return nil, NewClientError("failed to parse callback form", 0, err)
+	}
+	body := r.FormValue("json")
+	if body == "" {
+		return nil, NewClientError(fmt.Sprintf("callback %s %s has no json field", r.Method, r.URL.Path), 0, nil)
+	}
+	return ParseEvent([]byte(body), apiKey)
+}
+
+// EventDetails contains the details of a callback event.
+type EventDetails struct {
+	// EventTime is the Unix timestamp, as a string, when the event occurred
+	EventTime string `json:"event_time"`
+	// EventType is the type of the event
+	EventType EventType `json:"event_type"`
+	// EventHash is an HMAC-SHA256 of EventTime and EventType keyed by the API key
+	EventHash string `json:"event_hash"`
+	// EventMetadata contains additional information about the event
+	EventMetadata *EventMetadata `json:"event_metadata,omitempty"`
+}
+
+// EventMetadata contains additional information about a callback event.
+type EventMetadata struct {
+	// RelatedSignatureID is the ID of the signature the event relates to, if any
+	RelatedSignatureID *string `json:"related_signature_id,omitempty"`
+	// ReportedForAccountID is the ID of the account the event was reported for
+	ReportedForAccountID *string `json:"reported_for_account_id,omitempty"`
+	// ReportedForAppID is the client ID of the app the event was reported for
+	ReportedForAppID *string `json:"reported_for_app_id,omitempty"`
+	// EventMessage is a message describing the event, if any
+	EventMessage *string `json:"event_message,omitempty"`
+}
+
+// DedupKey returns a key that identifies the event across repeated deliveries.
+//
+// Dropbox Sign may deliver the same callback more than once. Every delivery
+// of an event carries the same event time, type, signature request and
+// related signature, so the key is derived from those. EventHash is not used
+// because it depends on the API key and does not include the signature
+// request, so two events of the same type in the same second share it.
+//
+// Example:
+//
+//	if seen, _ := store.MarkSeen(ctx, event.DedupKey()); seen {
+//		return // already processed
+//	}
+func (e *Event) DedupKey() string {
+	parts := []string{e.Event.EventTime, string(e.Event.EventType), "", ""}
+	if e.SignatureRequest != nil {
+		parts[2] = e.SignatureRequest.SignatureRequestID
+	}
+	if metadata := e.Event.EventMetadata; metadata != nil && metadata.RelatedSignatureID != nil {
+		parts[3] = *metadata.RelatedSignatureID
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// SeenStore records which events have already been processed, for
+// deduplicating repeated callback deliveries.
+//
+// Implementations backed by a shared store, such as Redis or a database
+// table with a unique key, make deduplication work across processes.
+// Implementations must be safe for concurrent use.
+type SeenStore interface {
+	// MarkSeen records key and reports whether it had already been recorded
+	MarkSeen(ctx context.Context, key string) (bool, error)
+}
+
+// MemorySeenStore is an in-memory SeenStore for a single process.
+//
+// Keys are forgotten after the retention period passed to NewMemorySeenStore,
+// which should be longer than the window in which Dropbox Sign retries a
+// callback.
+type MemorySeenStore struct {
+	mu        sync.Mutex
+	retention time.Duration
+	seen      map[string]time.Time
+	now       func() time.Time
+}
+
+var _ SeenStore = (*MemorySeenStore)(nil)
+
+// NewMemorySeenStore creates an in-memory SeenStore that remembers keys for
+// the given retention period.
+//
+// Example:
+//
+//	store := dropboxsign.NewMemorySeenStore(24 * time.Hour)
+func NewMemorySeenStore(retention time.Duration) *MemorySeenStore {
+	return &MemorySeenStore{
+		retention: retention,
+		seen:      make(map[string]time.Time),
+		now:       time.Now,
+	}
+}
+
+// MarkSeen records key and reports whether it had already been recorded
+// within the retention period.
+func (s *MemorySeenStore) MarkSeen(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for k, at := range s.seen {
+		if now.Sub(at) > s.retention {
+			delete(s.seen, k)
+		}
+	}
+
+	if _, ok := s.seen[key]; ok {
+		return true, nil
+	}
+	s.seen[key] = now
+	return false, nil
+}
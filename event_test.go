@@ -0,0 +1,165 @@
+package dropboxsign
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testEventJSON = `{
+	"event": {
+		"event_time": "1700000000",
+		"event_type": "signature_request_signed",
+		"event_hash": "3a1f",
+		"event_metadata": {"related_signature_id": "sig-1"}
+	},
+	"signature_request": {"signature_request_id": "abc123"}
+}`
+
+func TestEvent_DedupKey(t *testing.T) {
+	var first, retry Event
+	if err := json.Unmarshal([]byte(testEventJSON), &first); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+	if err := json.Unmarshal([]byte(testEventJSON), &retry); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+
+	if first.DedupKey() != retry.DedupKey() {
+		t.Error("expected repeated deliveries to share a dedup key")
+	}
+
+	other := first
+	other.SignatureRequest = &SignatureRequestResponse{SignatureRequestID: "def456"}
+	if other.DedupKey() == first.DedupKey() {
+		t.Error("expected events for different signature requests to have different dedup keys")
+	}
+
+	relatedSignatureID := "sig-2"
+	other = first
+	other.Event.EventMetadata = &EventMetadata{RelatedSignatureID: &relatedSignatureID}
+	if other.DedupKey() == first.DedupKey() {
+		t.Error("expected events for different signatures to have different dedup keys")
+	}
+}
+
+func TestMemorySeenStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := NewMemorySeenStore(time.Hour)
+	store.now = func() time.Time { return now }
+
+	if seen, _ := store.MarkSeen(ctx, "key"); seen {
+		t.Error("expected first delivery to be unseen")
+	}
+	if seen, _ := store.MarkSeen(ctx, "key"); !seen {
+		t.Error("expected second delivery to be seen")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if seen, _ := store.MarkSeen(ctx, "key"); seen {
+		t.Error("expected key to be forgotten after the retention period")
+	}
+}
+
+// signedTestEvent returns a callback event body signed with apiKey.
+func signedTestEvent(t *testing.T, apiKey string) string {
+	t.Helper()
+
+	mac := hmac.New(sha256.New, []byte(apiKey))
+	mac.Write([]byte("1700000000signature_request_signed"))
+	return `{
+		"event": {
+			"event_time": "1700000000",
+			"event_type": "signature_request_signed",
+			"event_hash": "` + hex.EncodeToString(mac.Sum(nil)) + `"
+		},
+		"signature_request": {"signature_request_id": "abc123"},
+		"account_guid": "account-1"
+	}`
+}
+
+func TestParseEvent(t *testing.T) {
+	body := signedTestEvent(t, "test-api-key")
+
+	event, err := ParseEvent([]byte(body), "test-api-key")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.SignatureRequest.SignatureRequestID != "abc123" || event.AccountGUID == nil || *event.AccountGUID != "account-1" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	tests := []struct {
+		name   string
+		body   string
+		apiKey string
+	}{
+		{"wrong api key", body, "other-api-key"},
+		{"tampered event", strings.Replace(body, "signature_request_signed", "signature_request_declined", 1), "test-api-key"},
+		{"missing hash", `{"event": {"event_time": "1700000000", "event_type": "callback_test"}}`, "test-api-key"},
+		{"invalid json", "not json", "test-api-key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseEvent([]byte(tt.body), tt.apiKey); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestVerifyEventRequest(t *testing.T) {
+	body := signedTestEvent(t, "test-api-key")
+
+	var multipartBody bytes.Buffer
+	writer := multipart.NewWriter(&multipartBody)
+	if err := writer.WriteField("json", body); err != nil {
+		t.Fatalf("failed to write form field: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("failed to close multipart writer: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		wantErr     bool
+	}{
+		{"multipart", multipartBody.String(), writer.FormDataContentType(), false},
+		{"url encoded", url.Values{"json": {body}}.Encode(), "application/x-www-form-urlencoded", false},
+		{"no json field", url.Values{"other": {body}}.Encode(), "application/x-www-form-urlencoded", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+
+			event, err := VerifyEventRequest(r, "test-api-key")
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if event.Event.EventType != EventTypeSignatureRequestSigned {
+				t.Errorf("expected signature_request_signed, got %s", event.Event.EventType)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+// Package fakeclient provides an in-memory implementation of
+// dropboxsign.SignatureService for testing code that uses the Dropbox Sign client.
+//
+// The fake records every call so tests can assert on what was sent, and serves
+// signature requests that were either sent through it or added up front.
+//
+// Example:
+//
+//	fake := fakeclient.New()
+//	svc := NewContractService(fake) // accepts a dropboxsign.SignatureService
+//
+//	svc.SendContract(ctx, "jane@example.com")
+//
+//	if sends := fake.Sends(); len(sends) != 1 {
+//		t.Fatalf("expected 1 send, got %d", len(sends))
+//	}
+package fakeclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	dropboxsign "github.com/cjcox17/dropbox-sign-go"
+)
+
+// Reminder records a call to RemindSignatureRequest.
+type Reminder struct {
+	// SignatureRequestID is the ID of the signature request
+	SignatureRequestID string
+	// EmailAddress is the email address of the reminded signer
+	EmailAddress string
+	// Name is the signer name set with dropboxsign.WithRemindName, if any
+	Name *string
+}
+
+// Client is an in-memory fake of the Dropbox Sign signature request API.
+//
+// It is safe for concurrent use.
+type Client struct {
+	mu sync.Mutex
+
+	requests map[string]*dropboxsign.SignatureRequestResponse
+	order    []string
+	nextID   int
+	err      error
+
+	sends     []*dropboxsign.SendSignatureRequest
+	reminders []Reminder
+	cancels   []string
+	updates   []*dropboxsign.UpdateSignatureRequest
+}
+
+var _ dropboxsign.SignatureService = (*Client)(nil)
+
+// New creates an empty fake client.
+func New() *Client {
+	return &Client{
+		requests: make(map[string]*dropboxsign.SignatureRequestResponse),
+	}
+}
+
+// AddSignatureRequest stores a copy of a canned signature request that will be
+// served by GetSignatureRequest and ListSignatureRequests. Adding a request
+// with the ID of a stored one replaces it.
+func (c *Client) AddSignatureRequest(sigRequest *dropboxsign.SignatureRequestResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.store(sigRequest.Clone())
+}
+
+// SetError makes every subsequent call return err. Pass nil to clear it.
+func (c *Client) SetError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+// Sends returns copies of the requests passed to SendWithTemplate and
+// CreateEmbeddedWithTemplate, as they were when sent.
+func (c *Client) Sends() []*dropboxsign.SendSignatureRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*dropboxsign.SendSignatureRequest(nil), c.sends...)
+}
+
+// Reminders returns the reminders sent through RemindSignatureRequest.
+func (c *Client) Reminders() []Reminder {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Reminder(nil), c.reminders...)
+}
+
+// Cancellations returns the IDs passed to CancelIncompleteSignatureRequest.
+func (c *Client) Cancellations() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.cancels...)
+}
+
+// Updates returns copies of the requests passed to UpdateSignatureRequest, as
+// they were when applied.
+func (c *Client) Updates() []*dropboxsign.UpdateSignatureRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*dropboxsign.UpdateSignatureRequest(nil), c.updates...)
+}
+
+// GetSignatureRequest returns a copy of a stored signature request, or a 404
+// error if no request with that ID exists.
+func (c *Client) GetSignatureRequest(_ context.Context, signatureRequestID string) (*dropboxsign.SignatureRequestResponse, []dropboxsign.WarningResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	sigRequest, err := c.lookup(signatureRequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sigRequest.Clone(), nil, nil
+}
+
+// ListSignatureRequests returns every stored signature request on a single
+// page, in the order they were sent or added.
+func (c *Client) ListSignatureRequests(_ context.Context, _ *dropboxsign.ListSignatureRequestsOptions) (*dropboxsign.SignatureRequestListResponse, []dropboxsign.WarningResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	list := &dropboxsign.SignatureRequestListResponse{
+		ListInfo: dropboxsign.ListInfo{Page: 1, NumPages: 1, PageSize: len(c.requests)},
+	}
+	for _, id := range c.order {
+		list.SignatureRequests = append(list.SignatureRequests, *c.requests[id].Clone())
+	}
+	return list, nil, nil
+}
+
+// SendWithTemplate records the request and stores a new signature request built from it.
+func (c *Client) SendWithTemplate(_ context.Context, request *dropboxsign.SendSignatureRequest) (*dropboxsign.SignatureRequestResponse, []dropboxsign.WarningResponse, error) {
+	return c.send(request, false)
+}
+
+// CreateEmbeddedWithTemplate records the request and stores a new signature request built from it.
+func (c *Client) CreateEmbeddedWithTemplate(_ context.Context, request *dropboxsign.SendSignatureRequest) (*dropboxsign.SignatureRequestResponse, []dropboxsign.WarningResponse, error) {
+	return c.send(request, true)
+}
+
+// UpdateSignatureRequest records the request and applies it to the stored signature request.
+func (c *Client) UpdateSignatureRequest(_ context.Context, signatureRequestID string, request *dropboxsign.UpdateSignatureRequest) (*dropboxsign.SignatureRequestResponse, []dropboxsign.WarningResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	sigRequest, err := c.lookup(signatureRequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.updates = append(c.updates, cloneUpdate(request))
+	// Apply a separate copy, so the stored request shares nothing with the
+	// recorded update.
+	request = cloneUpdate(request)
+	if request.ExpiresAt != nil {
+		sigRequest.ExpiresAt = request.ExpiresAt
+	}
+	for i := range sigRequest.Signatures {
+		signature := &sigRequest.Signatures[i]
+		if signature.SignatureID != request.SignatureID {
+			continue
+		}
+		if request.EmailAddress != nil {
+			signature.SignerEmailAddress = *request.EmailAddress
+		}
+		if request.Name != nil {
+			signature.SignerName = request.Name
+		}
+	}
+	return sigRequest.Clone(), nil, nil
+}
+
+// RemindSignatureRequest records the reminder for a stored signature request.
+//
+// It returns a *dropboxsign.SignerAlreadySignedError if the signer with
+// emailAddress has signed.
+func (c *Client) RemindSignatureRequest(_ context.Context, signatureRequestID, emailAddress string, opts ...dropboxsign.RemindOption) (*dropboxsign.SignatureRequestResponse, []dropboxsign.WarningResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	sigRequest, err := c.lookup(signatureRequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := dropboxsign.NewRemindOptions(opts...)
+	for _, signature := range sigRequest.Signatures {
+		if signature.SignerEmailAddress != emailAddress || dropboxsign.ParseSignerStatus(signature.StatusCode) != dropboxsign.SignerStatusSigned {
+			continue
+		}
+		if options.Name != nil && (signature.SignerName == nil || *signature.SignerName != *options.Name) {
+			continue
+		}
+		return nil, nil, &dropboxsign.SignerAlreadySignedError{
+			SignatureRequestID: signatureRequestID,
+			EmailAddress:       emailAddress,
+			Err: dropboxsign.ErrorResponseError{
+				Status:    http.StatusBadRequest,
+				ErrorName: "bad_request",
+				ErrorMsg:  "This signer has already signed",
+			},
+		}
+	}
+
+	c.reminders = append(c.reminders, Reminder{SignatureRequestID: signatureRequestID, EmailAddress: emailAddress, Name: options.Name})
+	return sigRequest.Clone(), nil, nil
+}
+
+// CancelIncompleteSignatureRequest records the cancellation. Like the API, it
+// keeps the canceled signature request readable, and returns a 400 error for
+// a request that is already complete.
+func (c *Client) CancelIncompleteSignatureRequest(_ context.Context, signatureRequestID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+
+	sigRequest, err := c.lookup(signatureRequestID)
+	if err != nil {
+		return err
+	}
+	if sigRequest.IsComplete {
+		return dropboxsign.ErrorResponseError{
+			Status:    http.StatusBadRequest,
+			ErrorName: "bad_request",
+			ErrorMsg:  "Signature request is already complete",
+		}
+	}
+
+	c.cancels = append(c.cancels, signatureRequestID)
+	return nil
+}
+
+// send records a send and stores the resulting signature request.
+func (c *Client) send(request *dropboxsign.SendSignatureRequest, embedded bool) (*dropboxsign.SignatureRequestResponse, []dropboxsign.WarningResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	request = request.Clone()
+	c.sends = append(c.sends, request)
+	c.nextID++
+
+	sigRequest := &dropboxsign.SignatureRequestResponse{
+		SignatureRequestID: fmt.Sprintf("fake-signature-request-%d", c.nextID),
+		TestMode:           request.TestMode,
+		Message:            request.Message,
+		Metadata:           request.Metadata,
+		TemplateIDs:        request.TemplateIDs,
+		IsEmbedded:         embedded,
+	}
+	if request.Title != nil {
+		sigRequest.Title = *request.Title
+		sigRequest.OriginalTitle = *request.Title
+	}
+	for i, signer := range request.Signers {
+		signer := signer
+		sigRequest.Signatures = append(sigRequest.Signatures, dropboxsign.SignatureRequestResponseSignatures{
+			SignatureID:        fmt.Sprintf("%s-signature-%d", sigRequest.SignatureRequestID, i+1),
+			SignerEmailAddress: signer.EmailAddress,
+			SignerName:         &signer.Name,
+			SignerRole:         &signer.Role,
+			Order:              signer.Order,
+			StatusCode:         string(dropboxsign.SignerStatusAwaitingSignature),
+			HasPin:             signer.Pin != nil,
+		})
+	}
+
+	// Store a copy, so the stored request shares nothing with the recorded send.
+	c.store(sigRequest.Clone())
+	return sigRequest, nil, nil
+}
+
+// store adds or replaces a signature request, keeping insertion order.
+// The caller must hold c.mu.
+func (c *Client) store(sigRequest *dropboxsign.SignatureRequestResponse) {
+	if _, ok := c.requests[sigRequest.SignatureRequestID]; !ok {
+		c.order = append(c.order, sigRequest.SignatureRequestID)
+	}
+	c.requests[sigRequest.SignatureRequestID] = sigRequest
+}
+
+// lookup returns the stored signature request or a 404 API error.
+// The caller must hold c.mu.
+func (c *Client) lookup(signatureRequestID string) (*dropboxsign.SignatureRequestResponse, error) {
+	sigRequest, ok := c.requests[signatureRequestID]
+	if !ok {
+		return nil, dropboxsign.ErrorResponseError{
+			Status:    http.StatusNotFound,
+			ErrorName: "not_found",
+			ErrorMsg:  "Signature request not found",
+		}
+	}
+	return sigRequest, nil
+}
+
+// cloneUpdate returns a copy of an update request that shares no pointers
+// with it, so later changes by the caller do not alter what was recorded.
+func cloneUpdate(request *dropboxsign.UpdateSignatureRequest) *dropboxsign.UpdateSignatureRequest {
+	clone := *request
+	if request.EmailAddress != nil {
+		emailAddress := *request.EmailAddress
+		clone.EmailAddress = &emailAddress
+	}
+	if request.Name != nil {
+		name := *request.Name
+		clone.Name = &name
+	}
+	if request.ExpiresAt != nil {
+		expiresAt := *request.ExpiresAt
+		clone.ExpiresAt = &expiresAt
+	}
+	return &clone
+}
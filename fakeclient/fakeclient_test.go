@@ -0,0 +1,230 @@
+package fakeclient
+
+import (
+	"context"
+	"testing"
+
+	dropboxsign "github.com/cjcox17/dropbox-sign-go"
+)
+
+func TestClient_SendAndGet(t *testing.T) {
+	fake := New()
+	ctx := context.Background()
+
+	signer := dropboxsign.NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := dropboxsign.NewSendSignatureRequest(
+		[]dropboxsign.SubSignatureRequestTemplateSigner{signer},
+		[]string{"template-id"},
+	).WithTitle("Contract")
+
+	var svc dropboxsign.SignatureService = fake
+
+	sent, _, err := svc.SendWithTemplate(ctx, request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, _, err := svc.GetSignatureRequest(ctx, sent.SignatureRequestID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Title != "Contract" {
+		t.Errorf("expected title 'Contract', got %s", got.Title)
+	}
+
+	if _, _, err := svc.RemindSignatureRequest(ctx, sent.SignatureRequestID, "john@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.CancelIncompleteSignatureRequest(ctx, sent.SignatureRequestID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := svc.GetSignatureRequest(ctx, sent.SignatureRequestID); err != nil {
+		t.Errorf("expected canceled request to stay readable, got %v", err)
+	}
+
+	if len(fake.Sends()) != 1 || len(fake.Reminders()) != 1 || len(fake.Cancellations()) != 1 {
+		t.Errorf("expected 1 send, reminder and cancellation, got %d, %d and %d",
+			len(fake.Sends()), len(fake.Reminders()), len(fake.Cancellations()))
+	}
+}
+
+func TestClient_SetError(t *testing.T) {
+	fake := New()
+	fake.SetError(dropboxsign.NewClientError("boom", 0, nil))
+
+	if _, _, err := fake.ListSignatureRequests(context.Background(), nil); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestClient_RemindSigned(t *testing.T) {
+	fake := New()
+	ctx := context.Background()
+
+	signer := dropboxsign.NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	sent, _, err := fake.SendWithTemplate(ctx, dropboxsign.NewSendSignatureRequest(
+		[]dropboxsign.SubSignatureRequestTemplateSigner{signer},
+		[]string{"template-id"},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := fake.RemindSignatureRequest(ctx, sent.SignatureRequestID, "john@example.com", dropboxsign.WithRemindName("John Doe")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reminders := fake.Reminders(); len(reminders) != 1 || reminders[0].Name == nil || *reminders[0].Name != "John Doe" {
+		t.Errorf("expected a reminder for John Doe, got %+v", reminders)
+	}
+
+	sent.Signatures[0].StatusCode = string(dropboxsign.SignerStatusSigned)
+	fake.AddSignatureRequest(sent)
+	if _, _, err := fake.RemindSignatureRequest(ctx, sent.SignatureRequestID, "john@example.com"); !dropboxsign.IsSignerAlreadySigned(err) {
+		t.Errorf("expected SignerAlreadySignedError, got %v", err)
+	}
+}
+
+func TestClient_CancelComplete(t *testing.T) {
+	fake := New()
+	fake.AddSignatureRequest(&dropboxsign.SignatureRequestResponse{SignatureRequestID: "complete", IsComplete: true})
+
+	if err := fake.CancelIncompleteSignatureRequest(context.Background(), "complete"); !dropboxsign.IsBadRequest(err) {
+		t.Errorf("expected BadRequest, got %v", err)
+	}
+	if cancels := fake.Cancellations(); len(cancels) != 0 {
+		t.Errorf("expected no recorded cancellations, got %v", cancels)
+	}
+}
+
+func TestClient_ListOrder(t *testing.T) {
+	fake := New()
+	ids := []string{"c", "a", "d", "b", "e"}
+	for _, id := range ids {
+		fake.AddSignatureRequest(&dropboxsign.SignatureRequestResponse{SignatureRequestID: id})
+	}
+	fake.AddSignatureRequest(&dropboxsign.SignatureRequestResponse{SignatureRequestID: "a", Title: "replaced"})
+
+	list, _, err := fake.ListSignatureRequests(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.SignatureRequests) != len(ids) {
+		t.Fatalf("expected %d requests, got %d", len(ids), len(list.SignatureRequests))
+	}
+	for i, sigRequest := range list.SignatureRequests {
+		if sigRequest.SignatureRequestID != ids[i] {
+			t.Errorf("expected request %d to be %s, got %s", i, ids[i], sigRequest.SignatureRequestID)
+		}
+	}
+	if list.SignatureRequests[1].Title != "replaced" {
+		t.Errorf("expected replaced request, got %+v", list.SignatureRequests[1])
+	}
+}
+
+func TestClient_SendsAreCopies(t *testing.T) {
+	fake := New()
+
+	signer := dropboxsign.NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := dropboxsign.NewSendSignatureRequest(
+		[]dropboxsign.SubSignatureRequestTemplateSigner{signer},
+		[]string{"template-id"},
+	).WithTitle("Contract")
+
+	if _, _, err := fake.SendWithTemplate(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	request.WithTitle("Changed")
+	request.Signers[0].EmailAddress = "other@example.com"
+
+	sent := fake.Sends()[0]
+	if *sent.Title != "Contract" || sent.Signers[0].EmailAddress != "john@example.com" {
+		t.Errorf("expected the request as sent, got title %s and signer %s", *sent.Title, sent.Signers[0].EmailAddress)
+	}
+}
+
+func TestClient_ResponsesAreCopies(t *testing.T) {
+	fake := New()
+	ctx := context.Background()
+
+	signer := dropboxsign.NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	request := dropboxsign.NewSendSignatureRequest(
+		[]dropboxsign.SubSignatureRequestTemplateSigner{signer},
+		[]string{"template-id"},
+	).WithTitle("Contract").WithMetadata(map[string]string{"customer_id": "42"})
+
+	sent, _, err := fake.SendWithTemplate(ctx, request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sent.Title = "Changed"
+	sent.Metadata["customer_id"] = "changed"
+	fake.Sends()[0].Metadata["customer_id"] = "changed"
+
+	got, _, err := fake.GetSignatureRequest(ctx, sent.SignatureRequestID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got.Signatures[0].SignerEmailAddress = "other@example.com"
+
+	reminded, _, err := fake.RemindSignatureRequest(ctx, sent.SignatureRequestID, "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reminded.Signatures[0].StatusCode = string(dropboxsign.SignerStatusSigned)
+
+	list, _, err := fake.ListSignatureRequests(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	*list.SignatureRequests[0].Signatures[0].SignerName = "Changed"
+
+	stored, _, err := fake.GetSignatureRequest(ctx, sent.SignatureRequestID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored.Title != "Contract" || stored.Metadata["customer_id"] != "42" {
+		t.Errorf("expected stored title and metadata unchanged, got %s and %v", stored.Title, stored.Metadata)
+	}
+	signature := stored.Signatures[0]
+	if signature.SignerEmailAddress != "john@example.com" || signature.StatusCode != string(dropboxsign.SignerStatusAwaitingSignature) || *signature.SignerName != "John Doe" {
+		t.Errorf("expected stored signature unchanged, got %+v", signature)
+	}
+}
+
+func TestClient_AddedAndUpdatedAreCopies(t *testing.T) {
+	fake := New()
+	ctx := context.Background()
+
+	added := &dropboxsign.SignatureRequestResponse{
+		SignatureRequestID: "sr-1",
+		Signatures: []dropboxsign.SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "john@example.com"},
+		},
+	}
+	fake.AddSignatureRequest(added)
+	added.Signatures[0].SignerEmailAddress = "other@example.com"
+
+	update := dropboxsign.NewUpdateSignatureRequest("sig-1").WithEmailAddress("new@example.com")
+	updated, _, err := fake.UpdateSignatureRequest(ctx, "sr-1", update)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Signatures[0].SignerEmailAddress != "new@example.com" {
+		t.Errorf("expected updated email address, got %s", updated.Signatures[0].SignerEmailAddress)
+	}
+	update.WithEmailAddress("later@example.com")
+
+	if recorded := fake.Updates()[0]; *recorded.EmailAddress != "new@example.com" {
+		t.Errorf("expected the update as applied, got %s", *recorded.EmailAddress)
+	}
+	stored, _, err := fake.GetSignatureRequest(ctx, "sr-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored.Signatures[0].SignerEmailAddress != "new@example.com" {
+		t.Errorf("expected stored email address new@example.com, got %s", stored.Signatures[0].SignerEmailAddress)
+	}
+}
@@ -0,0 +1,79 @@
+package dropboxsign
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithRequestGzip(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *SendSignatureRequest
+		check   func(t *testing.T, r *http.Request)
+	}{
+		{
+			name:    "json",
+			request: newValidRequest(),
+			check: func(t *testing.T, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+					return
+				}
+				if !strings.Contains(string(body), `"template_ids":["template-id"]`) {
+					t.Errorf("expected decompressed JSON body, got %s", body)
+				}
+			},
+		},
+		{
+			name:    "multipart",
+			request: newValidRequest().WithFiles([][]byte{[]byte("%PDF-1.4")}),
+			check: func(t *testing.T, r *http.Request) {
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					t.Errorf("failed to parse multipart body: %v", err)
+					return
+				}
+				if got := r.FormValue("template_ids[0]"); got != "template-id" {
+					t.Errorf("expected template_ids[0] template-id, got %q", got)
+				}
+				if len(r.MultipartForm.File["files[0]"]) != 1 {
+					t.Errorf("expected one uploaded file, got %v", r.MultipartForm.File)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if encoding := r.Header.Get("Content-Encoding"); encoding != "gzip" {
+					t.Errorf("expected Content-Encoding gzip, got %q", encoding)
+				}
+				gz, err := gzip.NewReader(r.Body)
+				if err != nil {
+					t.Errorf("failed to read gzip body: %v", err)
+					return
+				}
+				r.Body = gz
+				tt.check(t, r)
+
+				w.Header().Set("Content-Type", "application/json")
+				if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithRequestGzip(true)
+
+			if _, _, err := client.SendWithTemplate(context.Background(), tt.request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
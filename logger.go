@@ -0,0 +1,153 @@
+package dropboxsign
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Logger receives a structured record of each API request made by the client.
+//
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	// Log is called once per API request, after it completes, and once per
+	// retried attempt when retry logging is enabled
+	Log(ctx context.Context, info RequestInfo)
+}
+
+// RequestInfo describes a single API request.
+type RequestInfo struct {
+	// Method is the HTTP method of the request
+	Method string
+	// Path is the URL path of the request, relative to the host. The API key
+	// is replaced by "***" should it appear in the path, such as when passed
+	// by mistake as an ID.
+	Path string
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received
+	StatusCode int
+	// Duration is how long the request took
+	Duration time.Duration
+	// DryRun indicates the request was not sent because the client is in dry-run mode
+	DryRun bool
+	// Err is the error returned for the request, if any
+	Err error
+	// Attempt is the 1-based number of the attempt the record describes. For
+	// the record logged when the request completes, it is the total number
+	// of attempts made.
+	Attempt int
+	// RetryDelay is how long the client waits before the next attempt. It is
+	// only set on the records of retried attempts (see WithRetryLogging).
+	RetryDelay time.Duration
+	// CancelReason is the reason given with WithCancelReason, set only on the
+	// records of cancel requests
+	CancelReason string
+	// WarningsErr is the error decoding the warnings of a successful response.
+	// The warnings are then returned as a single WarningNameMalformedWarnings
+	// warning instead.
+	WarningsErr error
+}
+
+// NopLogger is a Logger that discards every record. A client behaves as if
+// it were set until WithLogger is called.
+type NopLogger struct{}
+
+var _ Logger = NopLogger{}
+
+// Log discards info.
+func (NopLogger) Log(context.Context, RequestInfo) {}
+
+// SlogLogger is a Logger that writes each record to a *slog.Logger.
+//
+// Completed requests are logged at slog.LevelInfo, retried attempts at
+// slog.LevelWarn and failed requests at slog.LevelError, with the fields of
+// RequestInfo as attributes.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").
+//		WithLogger(dropboxsign.NewSlogLogger(slog.Default()))
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+var _ Logger = (*SlogLogger)(nil)
+
+// NewSlogLogger creates a Logger that writes to logger.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	return &SlogLogger{logger: logger}
+}
+
+// Log writes info to the slog logger.
+func (l *SlogLogger) Log(ctx context.Context, info RequestInfo) {
+	level := slog.LevelInfo
+	attrs := []slog.Attr{
+		slog.String("method", info.Method),
+		slog.String("path", info.Path),
+		slog.Int("status", info.StatusCode),
+		slog.Duration("duration", info.Duration),
+		slog.Int("attempt", info.Attempt),
+	}
+	if info.RetryDelay > 0 {
+		level = slog.LevelWarn
+		attrs = append(attrs, slog.Duration("retry_delay", info.RetryDelay))
+	}
+	if info.DryRun {
+		attrs = append(attrs, slog.Bool("dry_run", true))
+	}
+	if info.CancelReason != "" {
+		attrs = append(attrs, slog.String("cancel_reason", info.CancelReason))
+	}
+	if info.WarningsErr != nil {
+		attrs = append(attrs, slog.String("warnings_error", info.WarningsErr.Error()))
+	}
+	if info.Err != nil {
+		if info.RetryDelay == 0 {
+			level = slog.LevelError
+		}
+		attrs = append(attrs, slog.String("error", info.Err.Error()))
+	}
+	l.logger.LogAttrs(ctx, level, "dropboxsign request", attrs...)
+}
+
+// WithLogger sets a logger that receives a record of every API request.
+//
+// Pass nil or NopLogger to stop logging. See SlogLogger to log with log/slog.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithLogger(logger Logger) *Client {
+	c.logger = logger
+	return c
+}
+
+// WithRetryLogging enables a log record for each attempt that fails and is
+// retried, in addition to the record logged when a request completes.
+//
+// Each record carries the attempt number, the status code or error that
+// triggered the retry and the delay before the next attempt, which makes a
+// burst of retries against a degraded API visible even when the request
+// eventually succeeds. It has no effect without WithLogger.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithRetryLogging(enabled bool) *Client {
+	c.retryLogging = enabled
+	return c
+}
+
+// log sends info to the configured logger, if any.
+func (c *Client) log(ctx context.Context, info RequestInfo) {
+	if c.logger != nil {
+		c.logger.Log(ctx, info)
+	}
+}
+
+// loggedPath returns the URL path of req for a log record, with the API key
+// sent in its basic auth header replaced by "***".
+func loggedPath(req *http.Request) string {
+	apiKey, _, ok := req.BasicAuth()
+	if !ok || apiKey == "" {
+		return req.URL.Path
+	}
+	return strings.ReplaceAll(req.URL.Path, apiKey, "***")
+}
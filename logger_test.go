@@ -0,0 +1,86 @@
+package dropboxsign
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSlogLogger(t *testing.T) {
+	tests := []struct {
+		name      string
+		info      RequestInfo
+		wantLevel string
+		wantAttrs map[string]any
+	}{
+		{
+			name:      "completed",
+			info:      RequestInfo{Method: http.MethodGet, Path: "/v3/account", StatusCode: http.StatusOK, Duration: time.Second, Attempt: 1},
+			wantLevel: "INFO",
+			wantAttrs: map[string]any{"method": "GET", "path": "/v3/account", "status": float64(200), "attempt": float64(1)},
+		},
+		{
+			name:      "retried",
+			info:      RequestInfo{Method: http.MethodGet, Path: "/v3/account", StatusCode: http.StatusServiceUnavailable, Attempt: 1, RetryDelay: time.Second, Err: errors.New("unavailable")},
+			wantLevel: "WARN",
+			wantAttrs: map[string]any{"status": float64(503), "error": "unavailable"},
+		},
+		{
+			name:      "failed",
+			info:      RequestInfo{Method: http.MethodPost, Path: "/v3/signature_request/cancel/abc123", StatusCode: http.StatusNotFound, Attempt: 1, Err: errors.New("not found"), CancelReason: "superseded"},
+			wantLevel: "ERROR",
+			wantAttrs: map[string]any{"error": "not found", "cancel_reason": "superseded"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+			logger.Log(context.Background(), tt.info)
+
+			var record map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t.Fatalf("failed to decode log record %q: %v", buf.String(), err)
+			}
+			if record["level"] != tt.wantLevel {
+				t.Errorf("expected level %s, got %v", tt.wantLevel, record["level"])
+			}
+			for key, want := range tt.wantAttrs {
+				if record[key] != want {
+					t.Errorf("expected %s=%v, got %v", key, want, record[key])
+				}
+			}
+		})
+	}
+}
+
+func TestLogger_RedactsAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		if _, err := w.Write([]byte(`{"error": {"error_msg": "Not found", "error_name": "not_found"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client := NewClient("secret-api-key").WithBaseURL(server.URL + "/v3").WithLogger(logger)
+
+	// The API key passed by mistake in place of a signature request ID.
+	if _, _, err := client.GetSignatureRequest(context.Background(), "secret-api-key"); !IsNotFound(err) {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+
+	if len(logger.infos) != 1 || logger.infos[0].Path != "/v3/signature_request/***" {
+		t.Errorf("expected the API key to be redacted, got %+v", logger.infos)
+	}
+}
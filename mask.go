@@ -0,0 +1,45 @@
+package dropboxsign
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maskPhoneNumber masks all but the last two digits of a phone number,
+// preserving a leading "+" so the format remains recognizable.
+func maskPhoneNumber(phoneNumber string) string {
+	if phoneNumber == "" {
+		return ""
+	}
+
+	prefix := ""
+	digits := phoneNumber
+	if strings.HasPrefix(digits, "+") {
+		prefix = "+"
+		digits = digits[1:]
+	}
+
+	if len(digits) <= 2 {
+		return prefix + strings.Repeat("*", len(digits))
+	}
+
+	return prefix + strings.Repeat("*", len(digits)-2) + digits[len(digits)-2:]
+}
+
+// maskEmail masks the local part of an email address, keeping its first
+// character and the full domain (e.g. "jane@example.com" becomes "j***@example.com").
+// The first character is kept whole even when it takes several bytes, as in
+// "émilie@example.com".
+func maskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return "***"
+	}
+
+	_, size := utf8.DecodeRuneInString(email)
+	return email[:size] + "***" + email[at:]
+}
@@ -0,0 +1,30 @@
+package dropboxsign
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"", ""},
+		{"jane@example.com", "j***@example.com"},
+		{"émilie@example.com", "é***@example.com"},
+		{"张伟@example.cn", "张***@example.cn"},
+		{"@example.com", "***"},
+		{"not-an-email", "***"},
+	}
+
+	for _, tt := range tests {
+		got := maskEmail(tt.email)
+		if got != tt.want {
+			t.Errorf("maskEmail(%q) = %q, expected %q", tt.email, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("maskEmail(%q) = %q is not valid UTF-8", tt.email, got)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package dropboxsign
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strconv"
+)
+
+// fileExtensions maps the content types http.DetectContentType reports for
+// documents the API accepts to the file extension the API expects.
+//
+// Office documents are detected as ZIP or OLE archives, which say nothing
+// about the document type, so they are left out; callers uploading them must
+// set a file name.
+var fileExtensions = map[string]string{
+	"application/pdf":           ".pdf",
+	"image/png":                 ".png",
+	"image/jpeg":                ".jpg",
+	"image/gif":                 ".gif",
+	"image/bmp":                 ".bmp",
+	"text/plain; charset=utf-8": ".txt",
+	"text/html; charset=utf-8":  ".html",
+}
+
+// writeFileParts adds each file to the multipart writer as "files[i]",
+// with a content type detected from the file contents.
+//
+// The API documents the upload field as "files[i]"; the older "file[i]"
+// name is only kept for compatibility with legacy HelloSign clients, so it
+// is not used. Each file is named after the matching entry of names, since
+// the API shows the file name to signers and goes by its extension for the
+// file type. Files without a name are named "file<i>" with an extension
+// derived from the detected content type, such as "file0.pdf".
+func writeFileParts(w *multipart.Writer, files [][]byte, names []string) error {
+	for i, file := range files {
+		contentType := http.DetectContentType(file)
+		filename := fmt.Sprintf("file%d%s", i, fileExtensions[contentType])
+		if i < len(names) && names[i] != "" {
+			filename = names[i]
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="files[%d]"; filename=%q`, i, filename))
+		header.Set("Content-Type", contentType)
+
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if _, err := part.Write(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamMultipart returns a reader that streams the multipart body produced by
+// write, along with the body's content type.
+//
+// The body is written by a goroutine through an io.Pipe, so large files are
+// not buffered in memory. The goroutine exits when write finishes, when the
+// reader is closed, or when ctx is done, whichever comes first; a canceled
+// upload surfaces ctx.Err() to the reader. The caller must close the reader,
+// which http.Client.Do does for request bodies even on error.
+func streamMultipart(ctx context.Context, write func(w *multipart.Writer) error) (io.ReadCloser, string) {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		stop := context.AfterFunc(ctx, func() {
+			pw.CloseWithError(ctx.Err())
+		})
+		defer stop()
+
+		err := write(writer)
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return pr, writer.FormDataContentType()
+}
+
+// multipartBody returns the multipart body produced by write, along with the
+// body's content type.
+//
+// The body is streamed (see streamMultipart) unless the client retries
+// overload responses (see WithRetry), in which case it is built in memory as
+// a *bytes.Reader, which http.NewRequest knows how to replay. The caller must
+// close the body if it is an io.Closer.
+func (c *Client) multipartBody(ctx context.Context, write func(w *multipart.Writer) error) (io.Reader, string, error) {
+	if c.maxRetries == 0 {
+		body, contentType := streamMultipart(ctx, write)
+		return body, contentType, nil
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := write(writer); err != nil {
+		return nil, "", err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return bytes.NewReader(buf.Bytes()), writer.FormDataContentType(), nil
+}
+
+// writeFormFields adds the JSON document data to the multipart writer as form
+// fields, using bracket notation for nested values (e.g. "signers[0][name]").
+func writeFormFields(w *multipart.Writer, data []byte) error {
+	var fields map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return err
+	}
+	return writeFormValue(w, "", fields)
+}
+
+// writeFormValue writes value under the field name, recursing into objects
+// and arrays.
+func writeFormValue(w *multipart.Writer, name string, value any) error {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			field := key
+			if name != "" {
+				field = name + "[" + key + "]"
+			}
+			if err := writeFormValue(w, field, v[key]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		for i, item := range v {
+			if err := writeFormValue(w, name+"["+strconv.Itoa(i)+"]", item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case nil:
+		return nil
+	case string:
+		return w.WriteField(name, v)
+	default:
+		return w.WriteField(name, fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,203 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestUpdateTemplateFiles_CanceledMidUpload(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.CopyN(io.Discard, r.Body, 1<<20); err != nil {
+			t.Errorf("failed to read start of upload: %v", err)
+		}
+		close(started)
+		// Stop reading so the upload stalls until the client cancels it.
+		<-release
+	}))
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	files := [][]byte{make([]byte, 16<<20), make([]byte, 16<<20)}
+	err := client.UpdateTemplateFiles(ctx, "template-id", files)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error wrapping context.Canceled, got %v", err)
+	}
+
+	close(release)
+	server.Close()
+	client.httpClient.CloseIdleConnections()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > baseline {
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			t.Fatalf("expected goroutines to return to %d, got %d:\n%s", baseline, runtime.NumGoroutine(), buf[:runtime.Stack(buf, true)])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSendWithTemplate_Files(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("failed to parse multipart form: %v", err)
+		}
+
+		expected := map[string]string{
+			"template_ids[0]":               "template-id",
+			"signers[0][role]":              "Signer",
+			"signers[0][email_address]":     "john@example.com",
+			"title":                         "Contract",
+			"test_mode":                     "true",
+			"metadata[customer_id]":         "42",
+			"signing_options[draw]":         "true",
+			"signing_options[default_type]": "draw",
+		}
+		for field, want := range expected {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("expected %s=%q, got %q", field, want, got)
+			}
+		}
+
+		if files := r.MultipartForm.File["files[0]"]; len(files) != 1 {
+			t.Errorf("expected files[0] part, got %v", r.MultipartForm.File)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	request := newValidRequest().
+		WithTitle("Contract").
+		WithTestMode(true).
+		WithMetadata(map[string]string{"customer_id": "42"}).
+		WithSigningOptions(NewSubSigningOptions(SubSigningOptionsDefaultTypeDraw).WithDraw(true)).
+		WithFiles([][]byte{[]byte("%PDF-1.4\n%fake pdf")})
+
+	if _, _, err := client.SendWithTemplate(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSend_Files(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/send" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("failed to parse multipart form: %v", err)
+			return
+		}
+
+		expected := map[string]string{
+			"signers[0][name]":          "Jane Doe",
+			"signers[0][email_address]": "jane@example.com",
+			"signers[1][name]":          "John Doe",
+			"title":                     "Contract",
+			"message":                   "Please sign",
+			"test_mode":                 "true",
+		}
+		for field, want := range expected {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("expected %s=%q, got %q", field, want, got)
+			}
+		}
+		if _, ok := r.MultipartForm.Value["template_ids[0]"]; ok {
+			t.Error("expected no template_ids field")
+		}
+
+		for i, want := range []struct{ contentType, filename string }{
+			{"application/pdf", "contract.pdf"},
+			{"text/plain; charset=utf-8", "file1.txt"},
+		} {
+			files := r.MultipartForm.File["files["+strconv.Itoa(i)+"]"]
+			if len(files) != 1 {
+				t.Errorf("expected files[%d] part, got %v", i, r.MultipartForm.File)
+				continue
+			}
+			if contentType := files[0].Header.Get("Content-Type"); contentType != want.contentType {
+				t.Errorf("expected files[%d] content type %s, got %s", i, want.contentType, contentType)
+			}
+			if files[0].Filename != want.filename {
+				t.Errorf("expected files[%d] file name %s, got %s", i, want.filename, files[0].Filename)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	request := NewSendSignatureRequestWithFiles(
+		[]SubSignatureRequestTemplateSigner{
+			NewSubSignatureRequestTemplateSigner("", "Jane Doe", "jane@example.com"),
+			NewSubSignatureRequestTemplateSigner("", "John Doe", "john@example.com"),
+		},
+		[][]byte{[]byte("%PDF-1.4\n%fake pdf"), []byte("terms and conditions")},
+	).WithTitle("Contract").WithMessage("Please sign").WithTestMode(true).WithFileNames("contract.pdf")
+
+	sigRequest, _, err := client.Send(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sigRequest.SignatureRequestID != "abc123" {
+		t.Errorf("expected abc123, got %s", sigRequest.SignatureRequestID)
+	}
+}
+
+func TestSend_InvalidDocuments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+	signers := []SubSignatureRequestTemplateSigner{NewSubSignatureRequestTemplateSigner("", "Jane Doe", "jane@example.com")}
+	files := [][]byte{[]byte("%PDF-1.4")}
+
+	tests := []struct {
+		name    string
+		request *SendSignatureRequest
+	}{
+		{"files and file urls", NewSendSignatureRequestWithFiles(signers, files).WithFileURLs([]string{"https://example.com/contract.pdf"})},
+		{"no documents", NewSendSignatureRequestWithFiles(signers, nil)},
+		{"template ids", NewSendSignatureRequest(signers, []string{"template-id"}).WithFiles(files)},
+		{"more file names than files", NewSendSignatureRequestWithFiles(signers, files).WithFileNames("a.pdf", "b.pdf")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.Send(context.Background(), tt.request)
+			var clientErr *ClientError
+			if !errors.As(err, &clientErr) {
+				t.Errorf("expected ClientError, got %v", err)
+			}
+		})
+	}
+}
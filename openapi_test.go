@@ -0,0 +1,141 @@
+package dropboxsign
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// openAPIDocument is the subset of an OpenAPI document needed to compare
+// schema properties with the json tags of the response types.
+type openAPIDocument struct {
+	Components struct {
+		Schemas map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+// jsonFieldNames returns the json names of the fields of struct type t,
+// including those promoted from embedded structs.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if field.Anonymous && tag == "" {
+			for name := range jsonFieldNames(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+	return names
+}
+
+// TestResponseTypes_CoverOpenAPISchema checks that the response types model
+// every property documented by the Dropbox Sign OpenAPI schema.
+//
+// testdata/openapi_signature_request.json is an excerpt of the published
+// schema. When refreshing it from a newer version of the schema, this test
+// fails for each newly documented property until the model is updated.
+func TestResponseTypes_CoverOpenAPISchema(t *testing.T) {
+	data, err := os.ReadFile("testdata/openapi_signature_request.json")
+	if err != nil {
+		t.Fatalf("failed to read schema: %v", err)
+	}
+
+	var doc openAPIDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("failed to parse schema: %v", err)
+	}
+
+	types := map[string]reflect.Type{
+		"SignatureRequestResponse":                reflect.TypeOf(SignatureRequestResponse{}),
+		"SignatureRequestResponseSignatures":      reflect.TypeOf(SignatureRequestResponseSignatures{}),
+		"SignatureRequestResponseAttachment":      reflect.TypeOf(SignatureRequestResponseAttachment{}),
+		"SignatureRequestResponseCustomFieldBase": reflect.TypeOf(SignatureRequestResponseCustomFieldBase{}),
+		"SignatureRequestResponseDataBase":        reflect.TypeOf(SignatureRequestResponseData{}),
+
+		// Field subtypes are flattened into their base type in this package.
+		"SignatureRequestResponseCustomFieldText":     reflect.TypeOf(SignatureRequestResponseCustomFieldBase{}),
+		"SignatureRequestResponseCustomFieldCheckbox": reflect.TypeOf(SignatureRequestResponseCustomFieldBase{}),
+		"SignatureRequestResponseDataValueText":       reflect.TypeOf(SignatureRequestResponseData{}),
+		"SignatureRequestResponseDataValueCheckbox":   reflect.TypeOf(SignatureRequestResponseData{}),
+	}
+
+	for schemaName, schema := range doc.Components.Schemas {
+		goType, ok := types[schemaName]
+		if !ok {
+			t.Errorf("schema %s has no corresponding Go type", schemaName)
+			continue
+		}
+
+		fields := jsonFieldNames(goType)
+		var missing []string
+		for property := range schema.Properties {
+			if !fields[property] {
+				missing = append(missing, property)
+			}
+		}
+		sort.Strings(missing)
+
+		if len(missing) > 0 {
+			t.Errorf("%s is missing fields for schema %s properties: %s", goType.Name(), schemaName, strings.Join(missing, ", "))
+		}
+	}
+
+	for schemaName := range types {
+		if _, ok := doc.Components.Schemas[schemaName]; !ok {
+			t.Errorf("schema %s not found in testdata", schemaName)
+		}
+	}
+}
+
+func TestResponseTypes_DecodeBooleanFieldValues(t *testing.T) {
+	data := `{
+		"custom_fields": [
+			{"type": "checkbox", "name": "agree", "value": true},
+			{"type": "text", "name": "company", "value": "Acme"},
+			{"type": "text", "name": "empty", "value": null}
+		],
+		"response_data": [
+			{"api_id": "a1", "type": "checkbox", "value": false},
+			{"api_id": "a2", "type": "text", "value": "Jane"}
+		]
+	}`
+
+	var response SignatureRequestResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	values := []*string{
+		response.CustomFields[0].Value,
+		response.CustomFields[1].Value,
+		response.ResponseData[0].Value,
+		response.ResponseData[1].Value,
+	}
+	for i, want := range []string{"true", "Acme", "false", "Jane"} {
+		if values[i] == nil || *values[i] != want {
+			t.Errorf("expected value %d to be %q, got %v", i, want, values[i])
+		}
+	}
+	if response.CustomFields[2].Value != nil {
+		t.Errorf("expected null value to decode to nil, got %q", *response.CustomFields[2].Value)
+	}
+	if response.CustomFields[0].Name != "agree" || response.ResponseData[0].APIID == nil {
+		t.Error("expected other fields to be decoded")
+	}
+}
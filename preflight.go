@@ -0,0 +1,64 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+)
+
+// WithFileURLPreflight enables or disables checking file URLs before a
+// signature request is sent.
+//
+// When enabled, each of a request's FileURLs must be an HTTPS URL that answers
+// a HEAD request with a 2xx status and a PDF content type. Dropbox Sign fetches
+// file URLs asynchronously, so an unreachable file otherwise only surfaces as
+// an opaque failure minutes after the send succeeded. The HEAD requests are
+// sent without the API key.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithFileURLPreflight(enabled bool) *Client {
+	c.fileURLPreflight = enabled
+	return c
+}
+
+// preflightFileURLs checks that every file URL is HTTPS and reachable, and
+// returns all problems found as a single joined error.
+func (c *Client) preflightFileURLs(ctx context.Context, fileURLs []string) error {
+	errs := validateFileURLs(fileURLs)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for i, fileURL := range fileURLs {
+		if err := c.preflightFileURL(ctx, fileURL); err != nil {
+			errs = append(errs, fmt.Errorf("file_urls[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// preflightFileURL sends a HEAD request for fileURL and checks its response.
+func (c *Client) preflightFileURL(ctx context.Context, fileURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, fileURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClientFor(ctx).Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s returned status %d", fileURL, resp.StatusCode)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/pdf" {
+		return fmt.Errorf("%s has content type %q, expected application/pdf", fileURL, resp.Header.Get("Content-Type"))
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package dropboxsign
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFileURLPreflight(t *testing.T) {
+	files := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("expected HEAD request, got %s", r.Method)
+		}
+		if _, _, ok := r.BasicAuth(); ok {
+			t.Error("expected no API credentials to be sent to the file host")
+		}
+
+		switch r.URL.Path {
+		case "/contract.pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+		case "/page.html":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer files.Close()
+
+	var sent bool
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer api.Close()
+
+	tests := []struct {
+		name    string
+		fileURL string
+		wantErr bool
+	}{
+		{name: "reachable pdf", fileURL: files.URL + "/contract.pdf"},
+		{name: "not found", fileURL: files.URL + "/missing.pdf", wantErr: true},
+		{name: "not a pdf", fileURL: files.URL + "/page.html", wantErr: true},
+		{name: "not https", fileURL: "http://example.com/contract.pdf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent = false
+			client := NewClient("test-api-key").
+				WithBaseURL(api.URL + "/v3").
+				WithHTTPClient(files.Client()).
+				WithFileURLPreflight(true)
+
+			request := newValidRequest().WithFileURLs([]string{tt.fileURL})
+			_, _, err := client.SendWithTemplate(context.Background(), request)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if sent {
+					t.Error("expected request not to be sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !sent {
+				t.Error("expected request to be sent")
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package dropboxsign
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// queryDateLayout is the date format used by search query date ranges.
+const queryDateLayout = "2006-01-02"
+
+// QueryBuilder builds a search query for ListSignatureRequests.
+//
+// Each method adds one term; terms are combined with AND. String values are
+// quoted and escaped, so titles and emails containing spaces or quotes are
+// matched literally.
+//
+// Example:
+//
+//	query := dropboxsign.NewQueryBuilder().
+//		Complete(false).
+//		SignerEmail("jane@example.com").
+//		CreatedAfter(time.Now().AddDate(0, -1, 0))
+//
+//	opts := dropboxsign.NewListSignatureRequestsOptions().WithQuery(query.String())
+type QueryBuilder struct {
+	terms []string
+}
+
+// NewQueryBuilder creates an empty search query.
+func NewQueryBuilder() *QueryBuilder {
+	return &QueryBuilder{}
+}
+
+// Complete matches signature requests that are, or are not, fully signed.
+func (q *QueryBuilder) Complete(complete bool) *QueryBuilder {
+	return q.add("complete:" + strconv.FormatBool(complete))
+}
+
+// Declined matches signature requests that were, or were not, declined.
+func (q *QueryBuilder) Declined(declined bool) *QueryBuilder {
+	return q.add("declined:" + strconv.FormatBool(declined))
+}
+
+// Title matches signature requests whose title contains the given text.
+func (q *QueryBuilder) Title(contains string) *QueryBuilder {
+	return q.add("title:" + quoteQueryValue(contains))
+}
+
+// SignerEmail matches signature requests sent to the given email address.
+func (q *QueryBuilder) SignerEmail(email string) *QueryBuilder {
+	return q.add("to:" + quoteQueryValue(email))
+}
+
+// SenderEmail matches signature requests sent by the given email address.
+func (q *QueryBuilder) SenderEmail(email string) *QueryBuilder {
+	return q.add("from:" + quoteQueryValue(email))
+}
+
+// CreatedAfter matches signature requests created on or after the day of t.
+//
+// Dates are compared by calendar day in t's location.
+func (q *QueryBuilder) CreatedAfter(t time.Time) *QueryBuilder {
+	return q.add("created:[" + t.Format(queryDateLayout) + " TO *]")
+}
+
+// CreatedBefore matches signature requests created on or before the day of t.
+//
+// Dates are compared by calendar day in t's location.
+func (q *QueryBuilder) CreatedBefore(t time.Time) *QueryBuilder {
+	return q.add("created:[* TO " + t.Format(queryDateLayout) + "]")
+}
+
+// Metadata matches signature requests whose metadata has the given key and value.
+func (q *QueryBuilder) Metadata(key, value string) *QueryBuilder {
+	return q.add("metadata." + escapeQueryTerm(key) + ":" + quoteQueryValue(value))
+}
+
+// String returns the search query, or an empty string if no terms were added.
+func (q *QueryBuilder) String() string {
+	return strings.Join(q.terms, " AND ")
+}
+
+// add appends a term to the query.
+func (q *QueryBuilder) add(term string) *QueryBuilder {
+	q.terms = append(q.terms, term)
+	return q
+}
+
+// quoteQueryValue wraps value in double quotes, escaping backslashes and
+// quotes so it is matched as a literal phrase.
+func quoteQueryValue(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// escapeQueryTerm backslash-escapes characters that have a special meaning in
+// an unquoted search term, such as a field name.
+func escapeQueryTerm(term string) string {
+	var b strings.Builder
+	for _, r := range term {
+		if strings.ContainsRune(`\+-!():^[]"{}~*?|&/ `, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
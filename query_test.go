@@ -0,0 +1,60 @@
+package dropboxsign
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	created := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		query    *QueryBuilder
+		expected string
+	}{
+		{
+			name:     "empty",
+			query:    NewQueryBuilder(),
+			expected: "",
+		},
+		{
+			name:     "complete",
+			query:    NewQueryBuilder().Complete(true),
+			expected: "complete:true",
+		},
+		{
+			name:     "terms joined with and",
+			query:    NewQueryBuilder().Complete(false).SignerEmail("jane@example.com"),
+			expected: `complete:false AND to:"jane@example.com"`,
+		},
+		{
+			name:     "title with spaces and quotes",
+			query:    NewQueryBuilder().Title(`Jane's "NDA" v2`),
+			expected: `title:"Jane's \"NDA\" v2"`,
+		},
+		{
+			name:     "title with backslash",
+			query:    NewQueryBuilder().Title(`C:\contracts`),
+			expected: `title:"C:\\contracts"`,
+		},
+		{
+			name:     "created range",
+			query:    NewQueryBuilder().CreatedAfter(created).CreatedBefore(created.AddDate(0, 1, 0)),
+			expected: "created:[2024-03-05 TO *] AND created:[* TO 2024-04-05]",
+		},
+		{
+			name:     "metadata",
+			query:    NewQueryBuilder().Metadata("customer id", "42"),
+			expected: `metadata.customer\ id:"42"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitError is returned when the API rejects a request because the
+// account has exceeded its rate limit or quota.
+//
+// It embeds the ErrorResponseError parsed from the response, so the error
+// name and message remain available, and adds when the limit resets.
+type RateLimitError struct {
+	ErrorResponseError
+
+	// resetAt is when the rate limit window resets, or zero if unknown
+	resetAt time.Time
+	// retryAfter is the delay given by the Retry-After header, or zero if unknown
+	retryAfter time.Duration
+}
+
+// minRateLimitWait is the shortest time WaitForRateLimitReset waits, so that
+// a reset time already in the past, from clock skew or a stale header, does
+// not make a retry loop hammer the API.
+const minRateLimitWait = time.Second
+
+// ResetAt returns when the rate limit window resets and requests may be sent
+// again, as reported by the X-Ratelimit-Reset response header. It returns the
+// zero time if the API did not report a reset time.
+func (e RateLimitError) ResetAt() time.Time {
+	return e.resetAt
+}
+
+// Unwrap returns the underlying ErrorResponseError.
+func (e RateLimitError) Unwrap() error {
+	return e.ErrorResponseError
+}
+
+// newRateLimitError builds a RateLimitError from an API error and the headers
+// of the response it was parsed from.
+func newRateLimitError(apiErr ErrorResponseError, header http.Header) RateLimitError {
+	rateLimitErr := RateLimitError{ErrorResponseError: apiErr}
+	if reset, err := strconv.ParseInt(header.Get("X-Ratelimit-Reset"), 10, 64); err == nil && reset > 0 {
+		rateLimitErr.resetAt = time.Unix(reset, 0)
+	}
+	if delay, ok := parseRetryAfter(header.Get("Retry-After"), time.Now()); ok {
+		rateLimitErr.retryAfter = delay
+	}
+	return rateLimitErr
+}
+
+// WaitForRateLimitReset blocks until the rate limit window reported by err
+// resets, so a batch job can pause and resume instead of failing.
+//
+// It returns true once the window has reset and the request may be retried.
+// The wait lasts at least the Retry-After delay of the response, and at
+// least one second, even when the reported reset time has already passed.
+// It returns false immediately if err is not a RateLimitError with a known
+// reset time or Retry-After delay, and false if ctx is done before the
+// window resets.
+//
+// Example:
+//
+//	for {
+//		_, _, err := client.SendWithTemplate(ctx, request)
+//		if err != nil && dropboxsign.WaitForRateLimitReset(ctx, err) {
+//			continue
+//		}
+//		return err
+//	}
+func WaitForRateLimitReset(ctx context.Context, err error) bool {
+	var rateLimitErr RateLimitError
+	if !errors.As(err, &rateLimitErr) || (rateLimitErr.resetAt.IsZero() && rateLimitErr.retryAfter == 0) {
+		return false
+	}
+
+	wait := max(rateLimitErr.retryAfter, minRateLimitWait)
+	if !rateLimitErr.resetAt.IsZero() {
+		wait = max(wait, time.Until(rateLimitErr.resetAt))
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
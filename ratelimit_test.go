@@ -0,0 +1,81 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimitError(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+		if _, err := w.Write([]byte(`{"error": {"error_msg": "Rate limit exceeded", "error_name": "exceeded_rate"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+
+	_, _, err := client.GetSignatureRequest(context.Background(), "abc123")
+
+	rateLimitErr, ok := err.(RateLimitError)
+	if !ok {
+		t.Fatalf("expected RateLimitError, got %T: %v", err, err)
+	}
+	if !rateLimitErr.ResetAt().Equal(reset) {
+		t.Errorf("expected reset at %v, got %v", reset, rateLimitErr.ResetAt())
+	}
+	if rateLimitErr.ErrorName != "exceeded_rate" {
+		t.Errorf("expected error name exceeded_rate, got %s", rateLimitErr.ErrorName)
+	}
+	if !IsRateLimited(err) || !IsRetryable(err) {
+		t.Error("expected error to be rate limited and retryable")
+	}
+
+	var apiErr ErrorResponseError
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusTooManyRequests {
+		t.Errorf("expected wrapped ErrorResponseError with status 429, got %v", apiErr)
+	}
+}
+
+func TestWaitForRateLimitReset(t *testing.T) {
+	ctx := context.Background()
+
+	past := RateLimitError{resetAt: time.Now().Add(-time.Second)}
+	start := time.Now()
+	if !WaitForRateLimitReset(ctx, past) {
+		t.Error("expected true for a window that already reset")
+	}
+	if elapsed := time.Since(start); elapsed < minRateLimitWait {
+		t.Errorf("expected a stale reset time to wait at least %v, waited %v", minRateLimitWait, elapsed)
+	}
+
+	retryAfter := newRateLimitError(ErrorResponseError{Status: http.StatusTooManyRequests}, http.Header{"Retry-After": []string{"30"}})
+	if retryAfter.retryAfter != 30*time.Second {
+		t.Errorf("expected a 30s Retry-After delay, got %v", retryAfter.retryAfter)
+	}
+
+	if WaitForRateLimitReset(ctx, RateLimitError{}) {
+		t.Error("expected false without a reset time")
+	}
+
+	if WaitForRateLimitReset(ctx, errors.New("boom")) {
+		t.Error("expected false for other errors")
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	future := RateLimitError{resetAt: time.Now().Add(time.Hour)}
+	if WaitForRateLimitReset(cancelled, future) {
+		t.Error("expected false when the context is done")
+	}
+}
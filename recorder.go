@@ -0,0 +1,293 @@
+package dropboxsign
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"unicode/utf8"
+)
+
+// recordedHeaders are the headers kept in a recording. Everything else,
+// including the Authorization header carrying the API key, is dropped.
+var recordedHeaders = []string{"Content-Type", "Retry-After", "X-Ratelimit-Limit", "X-Ratelimit-Limit-Remaining", "X-Ratelimit-Reset"}
+
+// Interaction is a recorded API request and the response it received.
+//
+// A recording written by WithRecorder is a sequence of interactions, one
+// JSON object per line.
+type Interaction struct {
+	// Request is the recorded request
+	Request RecordedRequest `json:"request"`
+	// Response is the recorded response
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request in a recording.
+type RecordedRequest struct {
+	// Method is the HTTP method of the request
+	Method string `json:"method"`
+	// Path is the URL path and query of the request, without the host
+	Path string `json:"path"`
+	// Header contains the request's Content-Type and rate limit headers
+	Header http.Header `json:"header,omitempty"`
+	// Body is the request body
+	Body RecordedBody `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response in a recording.
+type RecordedResponse struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int `json:"status_code"`
+	// Header contains the response's Content-Type and rate limit headers
+	Header http.Header `json:"header,omitempty"`
+	// Body is the response body
+	Body RecordedBody `json:"body,omitempty"`
+}
+
+// RecordedBody is a request or response body in a recording. It is stored
+// as a string when it is valid UTF-8, so JSON bodies stay readable, and
+// base64-encoded otherwise, such as for PDF files.
+type RecordedBody []byte
+
+// MarshalJSON implements custom marshaling for RecordedBody.
+func (b RecordedBody) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON implements custom unmarshaling for RecordedBody.
+func (b *RecordedBody) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*b = RecordedBody(text)
+		return nil
+	}
+
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// WithRecorder writes every request the client makes, and the response it
+// receives, to w as a recording that NewReplayClient can serve.
+//
+// Credentials are never recorded: only the headers in the recording format
+// are kept, so the Authorization header is dropped. Request and response
+// bodies are recorded as sent, so recordings of live traffic contain signer
+// names and email addresses. Requests that fail without a response are not
+// recorded.
+//
+// Recording wraps the transport of whichever HTTP client sends each request,
+// including one set per call with the WithHTTPClient context option, in a
+// copy of that client; the clients themselves are never modified. Each
+// request body is read into memory before it is sent, and each response body
+// before it is returned, so neither multipart uploads nor downloads such as
+// DownloadFilesTo and DownloadFiles are streamed while recording. Pass a nil
+// w to stop recording.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	f, err := os.Create("testdata/send.jsonl")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer f.Close()
+//	client := dropboxsign.NewClient(apiKey).WithRecorder(f)
+func (c *Client) WithRecorder(w io.Writer) *Client {
+	if w == nil {
+		c.recorder = nil
+		return c
+	}
+	c.recorder = &recorder{w: w}
+	return c
+}
+
+// recorder serializes the interactions written to a recording.
+type recorder struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// wrap returns a copy of httpClient whose transport records each round trip.
+func (r *recorder) wrap(httpClient *http.Client) *http.Client {
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	wrapped := *httpClient
+	wrapped.Transport = &recordingTransport{next: transport, recorder: r}
+	return &wrapped
+}
+
+// recordingTransport is an http.RoundTripper that records each round trip.
+type recordingTransport struct {
+	next     http.RoundTripper
+	recorder *recorder
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			req.Body.Close()
+			return nil, err
+		}
+		req.Body.Close()
+
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	line, err := json.Marshal(Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.RequestURI(),
+			Header: recordedHeader(req.Header),
+			Body:   reqBody,
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     recordedHeader(resp.Header),
+			Body:       respBody,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	t.recorder.mu.Lock()
+	defer t.recorder.mu.Unlock()
+	if _, err := t.recorder.w.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("failed to write recording: %w", err)
+	}
+	return resp, nil
+}
+
+// recordedHeader returns the headers of h that are kept in a recording.
+func recordedHeader(h http.Header) http.Header {
+	recorded := make(http.Header)
+	for _, name := range recordedHeaders {
+		if values := h.Values(name); len(values) > 0 {
+			recorded[name] = values
+		}
+	}
+	if len(recorded) == 0 {
+		return nil
+	}
+	return recorded
+}
+
+// NewReplayClient creates a client that answers requests from a recording
+// written by WithRecorder, without making network calls.
+//
+// Each request is answered with the next unused recorded response for the
+// same method and path, so repeated calls replay in the order they were
+// recorded. A request with no remaining recorded response fails. Request
+// bodies are not compared.
+//
+// Example:
+//
+//	f, err := os.Open("testdata/send.jsonl")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer f.Close()
+//	client, err := dropboxsign.NewReplayClient(f)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+func NewReplayClient(recording io.Reader) (*Client, error) {
+	transport := &replayTransport{responses: make(map[string][]RecordedResponse)}
+
+	scanner := bufio.NewScanner(recording)
+	scanner.Buffer(nil, 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, NewClientError(fmt.Sprintf("invalid recording on line %d", line), 0, err)
+		}
+		key := interaction.Request.Method + " " + interaction.Request.Path
+		transport.responses[key] = append(transport.responses[key], interaction.Response)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, NewClientError("failed to read recording", 0, err)
+	}
+
+	return NewClient("replay").WithHTTPClient(&http.Client{Transport: transport}), nil
+}
+
+// replayTransport is an http.RoundTripper that serves recorded responses.
+type replayTransport struct {
+	mu        sync.Mutex
+	responses map[string][]RecordedResponse
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	key := req.Method + " " + req.URL.RequestURI()
+
+	t.mu.Lock()
+	responses := t.responses[key]
+	if len(responses) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("no recorded response for %s", key)
+	}
+	recorded := responses[0]
+	t.responses[key] = responses[1:]
+	t.mu.Unlock()
+
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
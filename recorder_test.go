@@ -0,0 +1,146 @@
+package dropboxsign
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRecorderAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+
+		var body string
+		switch r.URL.Path {
+		case "/v3/signature_request/send_with_template":
+			body = `{"signature_request": {"signature_request_id": "abc123", "title": "Sent"}}`
+		case "/v3/signature_request/abc123":
+			body = `{"signature_request": {"signature_request_id": "abc123", "title": "Fetched"}}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			body = `{"error": {"error_msg": "Not found", "error_name": "not_found"}}`
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	var recording bytes.Buffer
+	client := NewClient("secret-api-key").WithBaseURL(server.URL + "/v3").WithRecorder(&recording)
+
+	ctx := context.Background()
+	if _, _, err := client.SendWithTemplate(ctx, newValidRequest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := client.GetSignatureRequest(ctx, "abc123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := client.GetSignatureRequest(ctx, "missing"); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if strings.Contains(recording.String(), "secret-api-key") || strings.Contains(recording.String(), "X-Request-Id") {
+		t.Errorf("expected credentials and unlisted headers to be dropped, got %s", recording.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(recording.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 recorded interactions, got %d", len(lines))
+	}
+	var first Interaction
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("failed to decode interaction: %v", err)
+	}
+	if first.Request.Method != http.MethodPost || first.Request.Path != "/v3/signature_request/send_with_template" {
+		t.Errorf("unexpected recorded request: %s %s", first.Request.Method, first.Request.Path)
+	}
+	if !strings.Contains(string(first.Request.Body), `"template_ids":["template-id"]`) {
+		t.Errorf("expected request body to be recorded, got %s", first.Request.Body)
+	}
+
+	replay, err := NewReplayClient(&recording)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	replay.WithBaseURL(server.URL + "/v3")
+	server.Close()
+
+	sent, _, err := replay.SendWithTemplate(ctx, newValidRequest())
+	if err != nil {
+		t.Fatalf("unexpected replay error: %v", err)
+	}
+	if sent.Title != "Sent" {
+		t.Errorf("expected replayed title Sent, got %s", sent.Title)
+	}
+
+	fetched, _, err := replay.GetSignatureRequest(ctx, "abc123")
+	if err != nil {
+		t.Fatalf("unexpected replay error: %v", err)
+	}
+	if fetched.Title != "Fetched" {
+		t.Errorf("expected replayed title Fetched, got %s", fetched.Title)
+	}
+
+	if _, _, err := replay.GetSignatureRequest(ctx, "missing"); !IsNotFound(err) {
+		t.Errorf("expected replayed not found error, got %v", err)
+	}
+	if _, _, err := replay.GetSignatureRequest(ctx, "abc123"); err == nil || !strings.Contains(err.Error(), "no recorded response") {
+		t.Errorf("expected exhausted recording error, got %v", err)
+	}
+}
+
+func TestRecordedBody_Binary(t *testing.T) {
+	body := RecordedBody([]byte{0x25, 0x50, 0x44, 0x46, 0xff, 0xfe})
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), "base64") {
+		t.Errorf("expected binary body to be base64-encoded, got %s", data)
+	}
+
+	var decoded RecordedBody
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(decoded, body) {
+		t.Errorf("expected %v, got %v", body, decoded)
+	}
+}
+
+func TestRecorder_PerCallHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	owned := &http.Client{}
+	perCall := &http.Client{}
+
+	var recording bytes.Buffer
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithHTTPClient(owned).WithRecorder(&recording)
+
+	if _, _, err := client.GetSignatureRequest(context.Background(), "abc123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := client.GetSignatureRequest(WithHTTPClient(context.Background(), perCall), "abc123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(recording.String()), "\n"); len(lines) != 2 {
+		t.Errorf("expected 2 recorded interactions, got %d", len(lines))
+	}
+	if owned.Transport != nil || perCall.Transport != nil {
+		t.Error("expected the caller's HTTP clients to be left unchanged")
+	}
+}
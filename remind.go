@@ -0,0 +1,85 @@
+package dropboxsign
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RemindOptions are the optional settings of a reminder sent by
+// RemindSignatureRequest.
+type RemindOptions struct {
+	// Name is the name of the signer to remind, needed when several signers
+	// of the request share an email address
+	Name *string
+}
+
+// RemindOption configures a reminder sent by RemindSignatureRequest.
+type RemindOption func(*RemindOptions)
+
+// WithRemindName reminds the signer with the given name, which is needed when
+// several signers of the signature request share an email address.
+//
+// Example:
+//
+//	_, _, err := client.RemindSignatureRequest(ctx, signatureRequestID, "shared@example.com",
+//		dropboxsign.WithRemindName("Jane Doe"))
+func WithRemindName(name string) RemindOption {
+	return func(o *RemindOptions) {
+		o.Name = &name
+	}
+}
+
+// NewRemindOptions returns the settings produced by applying opts in order.
+//
+// It is useful for implementations of SignatureService, such as fakes, that
+// need to inspect the options passed to RemindSignatureRequest.
+func NewRemindOptions(opts ...RemindOption) RemindOptions {
+	var options RemindOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// SignerAlreadySignedError is returned by RemindSignatureRequest when the
+// signer has already signed, so there is nothing to remind them of.
+type SignerAlreadySignedError struct {
+	// SignatureRequestID is the ID of the signature request
+	SignatureRequestID string
+	// EmailAddress is the email address of the signer
+	EmailAddress string
+	// Err is the error returned by the API
+	Err ErrorResponseError
+}
+
+// Error implements the error interface for SignerAlreadySignedError.
+func (e *SignerAlreadySignedError) Error() string {
+	return fmt.Sprintf("signer %s has already signed signature request %s", e.EmailAddress, e.SignatureRequestID)
+}
+
+// Unwrap returns the error returned by the API.
+func (e *SignerAlreadySignedError) Unwrap() error {
+	return e.Err
+}
+
+// IsSignerAlreadySigned returns true if the error is, or wraps, a
+// SignerAlreadySignedError.
+func IsSignerAlreadySigned(err error) bool {
+	var signedErr *SignerAlreadySignedError
+	return errors.As(err, &signedErr)
+}
+
+// isAlreadySignedError reports whether err is the API's response to a
+// reminder for a signer who has already signed.
+//
+// The API has no dedicated error name for this; it answers with a bad request
+// whose message says the signer has already signed.
+func isAlreadySignedError(err error) (ErrorResponseError, bool) {
+	var apiErr ErrorResponseError
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusBadRequest {
+		return ErrorResponseError{}, false
+	}
+	return apiErr, strings.Contains(strings.ToLower(apiErr.ErrorMsg), "already signed")
+}
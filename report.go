@@ -0,0 +1,60 @@
+package dropboxsign
+
+import "time"
+
+// ReportType identifies the kind of report to generate.
+type ReportType string
+
+const (
+	// ReportTypeUserActivity reports the activity of each user on the account
+	ReportTypeUserActivity ReportType = "user_activity"
+	// ReportTypeDocumentStatus reports the status of each document sent
+	ReportTypeDocumentStatus ReportType = "document_status"
+)
+
+// reportDateLayout is the date format used by the report endpoints.
+const reportDateLayout = "01/02/2006"
+
+// CreateReportRequest represents a request to generate one or more reports.
+//
+// Example:
+//
+//	request := dropboxsign.NewCreateReportRequest(
+//		time.Now().AddDate(0, -1, 0),
+//		time.Now(),
+//		dropboxsign.ReportTypeDocumentStatus,
+//	)
+type CreateReportRequest struct {
+	// StartDate is the first day covered by the report, in MM/DD/YYYY format
+	StartDate string `json:"start_date"`
+	// EndDate is the last day covered by the report, in MM/DD/YYYY format
+	EndDate string `json:"end_date"`
+	// ReportType lists the reports to generate
+	ReportType []ReportType `json:"report_type"`
+}
+
+// NewCreateReportRequest creates a report request covering the days from
+// start to end, inclusive.
+func NewCreateReportRequest(start, end time.Time, reportTypes ...ReportType) *CreateReportRequest {
+	return &CreateReportRequest{
+		StartDate:  start.Format(reportDateLayout),
+		EndDate:    end.Format(reportDateLayout),
+		ReportType: reportTypes,
+	}
+}
+
+// ReportResponse describes a report request accepted by the API.
+//
+// Reports are generated asynchronously and delivered by email to the account
+// owner. The API has no endpoint to retrieve a report's status or download
+// link, so ReportResponse only confirms what was requested.
+type ReportResponse struct {
+	// Success is the confirmation message returned by the API
+	Success string `json:"success"`
+	// StartDate is the first day covered by the report, in MM/DD/YYYY format
+	StartDate string `json:"start_date"`
+	// EndDate is the last day covered by the report, in MM/DD/YYYY format
+	EndDate string `json:"end_date"`
+	// ReportType lists the reports being generated
+	ReportType []ReportType `json:"report_type"`
+}
@@ -0,0 +1,273 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultMaxNetworkRetries is the default number of times a request that
+	// failed with a transient network error is retried
+	DefaultMaxNetworkRetries = 2
+	// defaultNetworkRetryBackoff is the delay before the first network retry;
+	// it doubles with each further attempt
+	defaultNetworkRetryBackoff = 250 * time.Millisecond
+	// maxRetryBackoff caps the doubling of the WithRetry base delay
+	maxRetryBackoff = 5 * time.Minute
+)
+
+// WithMaxNetworkRetries sets how many times a request that fails with a
+// transient network error, such as a connection reset or timeout, is retried.
+//
+// Only requests that are safe to repeat are retried: GET requests always,
+// and POST requests only when made with a context marked by WithIdempotent.
+// A value of zero disables network retries. The default is
+// DefaultMaxNetworkRetries.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithMaxNetworkRetries(maxRetries int) *Client {
+	c.maxNetworkRetries = maxRetries
+	return c
+}
+
+// WithIdempotent returns a copy of ctx that marks API calls made with it as
+// safe to retry after a transient network error, even when they use POST.
+//
+// A POST that fails mid-flight may still have been processed by the API, so
+// retrying a send can create a duplicate signature request. Only use this for
+// calls whose repetition is harmless, such as updating a signer's email.
+//
+// Example:
+//
+//	ctx := dropboxsign.WithIdempotent(ctx)
+//	_, _, err := client.UpdateSignatureRequest(ctx, signatureRequestID, update)
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentContextKey, true)
+}
+
+// IsRetryable returns true if the request that produced err can safely be
+// sent again and may then succeed.
+//
+// This is the classification the client uses for its own network retries:
+// transient network errors (connection resets, refused connections and
+// timeouts) are retryable only when the request was idempotent, and rate
+// limit errors are always retryable because the API rejects them before
+// doing any work. Cancellation of the caller's context is never retryable.
+//
+// Example:
+//
+//	sigRequest, _, err := client.GetSignatureRequest(ctx, signatureRequestID)
+//	if dropboxsign.IsRetryable(err) {
+//		// back off and try again
+//	}
+func IsRetryable(err error) bool {
+	if IsRateLimited(err) {
+		return true
+	}
+	var clientErr *ClientError
+	return errors.As(err, &clientErr) && clientErr.retryable
+}
+
+// isIdempotent reports whether req can be sent again without side effects.
+//
+// Requests with a body are only idempotent if the body can be replayed.
+func isIdempotent(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	idempotent, _ := req.Context().Value(idempotentContextKey).(bool)
+	return idempotent
+}
+
+// isTransientNetworkError reports whether err, returned by an HTTP round
+// trip, is a network failure that may not recur.
+func isTransientNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// WithRetry makes the client retry requests that the API rejects because it
+// is overloaded, up to maxRetries times.
+//
+// Requests answered with 429 Too Many Requests wait for the time given by
+// the Retry-After header, in seconds or as an HTTP date, falling back to the
+// X-Ratelimit-Reset header and then to the backoff below. Since the API
+// rejects them before doing any work, they are retried whenever the request
+// body can be replayed. Requests answered with 502, 503 or 504 wait
+// baseDelay, doubling with each retry up to five minutes (or baseDelay, if
+// longer) and randomized by up to half to spread out clients retrying
+// together. Since the API may have processed them, they
+// are only retried when idempotent (see WithIdempotent).
+//
+// While retries are enabled, multipart uploads are built in memory instead of
+// streamed, so that they can be replayed. Waiting stops when the request's
+// context is done. When a request still fails after being retried, the error
+// keeps its type (ErrorResponseError, RateLimitError or *ClientError) and its
+// RetryCount field reports the number of retries. A
+// maxRetries of zero, the default, disables these retries; transient network
+// errors are retried separately (see WithMaxNetworkRetries).
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").WithRetry(3, time.Second)
+func (c *Client) WithRetry(maxRetries int, baseDelay time.Duration) *Client {
+	c.maxRetries = maxRetries
+	c.retryBaseDelay = baseDelay
+	return c
+}
+
+// sendWithRetries calls send, retrying transient network errors with
+// exponential backoff while the request is idempotent, and overload
+// responses as configured by WithRetry. It also returns the number of
+// attempts made.
+func (c *Client) sendWithRetries(req *http.Request) ([]byte, *http.Response, int, error) {
+	backoff := c.networkRetryBackoff
+	var networkRetries, statusRetries int
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		body, resp, err := c.send(req)
+		if err == nil {
+			return body, resp, attempt, nil
+		}
+
+		var delay time.Duration
+		var clientErr *ClientError
+		switch {
+		case errors.As(err, &clientErr) && clientErr.retryable && networkRetries < c.maxNetworkRetries:
+			networkRetries++
+			delay = backoff
+			backoff *= 2
+		case statusRetries < c.maxRetries && isRetryableStatus(req, resp):
+			statusRetries++
+			delay = c.statusRetryDelay(resp, err, statusRetries)
+		default:
+			return body, resp, attempt, withRetryCount(err, attempt-1)
+		}
+
+		if c.retryLogging {
+			info := RequestInfo{
+				Method:     req.Method,
+				Path:       loggedPath(req),
+				Duration:   time.Since(start),
+				Err:        err,
+				Attempt:    attempt,
+				RetryDelay: delay,
+			}
+			if resp != nil {
+				info.StatusCode = resp.StatusCode
+			}
+			c.log(req.Context(), info)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return body, resp, attempt, withRetryCount(err, attempt-1)
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, nil, attempt, NewClientError("failed to rewind request body", 0, err)
+			}
+		}
+	}
+}
+
+// isRetryableStatus reports whether the response to req is an overload
+// response that WithRetry retries.
+func isRetryableStatus(req *http.Request, resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+	return false
+}
+
+// statusRetryDelay returns how long to wait before the given retry, counted
+// from 1, of a request that received an overload response.
+func (c *Client) statusRetryDelay(resp *http.Response, err error, retry int) time.Duration {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return delay
+		}
+		if rateLimitErr, ok := err.(RateLimitError); ok && !rateLimitErr.resetAt.IsZero() {
+			return max(time.Until(rateLimitErr.resetAt), 0)
+		}
+	}
+
+	delay := c.retryBaseDelay
+	for i := 1; i < retry && delay > 0 && delay < maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, max(c.retryBaseDelay, maxRetryBackoff))
+	if half := int64(delay / 2); half > 0 {
+		delay = delay/2 + time.Duration(rand.Int63n(half+1))
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header value, given either as a
+// number of seconds or as an HTTP date, into a delay from now.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// withRetryCount records on err that the request was retried retries times.
+//
+// The RetryCount field of API and client errors is set without changing the
+// error's type, so that type assertions behave the same whether or not the
+// request was retried. Other errors are returned as is.
+func withRetryCount(err error, retries int) error {
+	if retries == 0 {
+		return err
+	}
+	switch e := err.(type) {
+	case ErrorResponseError:
+		e.RetryCount = retries
+		return e
+	case RateLimitError:
+		e.RetryCount = retries
+		return e
+	case *ClientError:
+		e.RetryCount = retries
+		return e
+	}
+	return err
+}
@@ -0,0 +1,382 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// flakyTransport fails the first failures round trips with a connection reset
+// and answers the rest with a signature request.
+func flakyTransport(failures int, attempts *int, bodies *[]string) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		*attempts++
+		if r.Body != nil {
+			data, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(data))
+		}
+		if *attempts <= failures {
+			return nil, fmt.Errorf("read tcp: %w", syscall.ECONNRESET)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"signature_request": {"signature_request_id": "abc123"}}`)),
+		}, nil
+	})
+}
+
+func TestNetworkRetries(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		call          func(ctx context.Context, c *Client) error
+		wantAttempts  int
+		wantErr       bool
+		wantRetryable bool
+	}{
+		{
+			name: "get is retried",
+			ctx:  context.Background(),
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetSignatureRequest(ctx, "abc123")
+				return err
+			},
+			wantAttempts: 2,
+		},
+		{
+			name: "post is not retried",
+			ctx:  context.Background(),
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.SendWithTemplate(ctx, newValidRequest())
+				return err
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name: "post is retried when idempotent",
+			ctx:  WithIdempotent(context.Background()),
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.UpdateSignatureRequest(ctx, "abc123", NewUpdateSignatureRequest("sig-1").WithName("Jane Doe"))
+				return err
+			},
+			wantAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			var bodies []string
+
+			client := NewClient("test-api-key").WithHTTPClient(&http.Client{Transport: flakyTransport(1, &attempts, &bodies)})
+			client.networkRetryBackoff = time.Millisecond
+
+			err := tt.call(tt.ctx, client)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && IsRetryable(err) != tt.wantRetryable {
+				t.Errorf("expected IsRetryable %v for %v", tt.wantRetryable, err)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+			for i := 1; i < len(bodies); i++ {
+				if bodies[i] != bodies[0] {
+					t.Errorf("expected retried body %q, got %q", bodies[0], bodies[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNetworkRetries_GivesUp(t *testing.T) {
+	var attempts int
+	var bodies []string
+
+	client := NewClient("test-api-key").
+		WithHTTPClient(&http.Client{Transport: flakyTransport(10, &attempts, &bodies)}).
+		WithMaxNetworkRetries(3)
+	client.networkRetryBackoff = time.Millisecond
+
+	_, _, err := client.GetSignatureRequest(context.Background(), "abc123")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !IsRetryable(err) {
+		t.Errorf("expected retryable error, got %v", err)
+	}
+	if attempts != 4 {
+		t.Errorf("expected 4 attempts, got %d", attempts)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "plain error", err: errors.New("boom"), expected: false},
+		{name: "rate limited", err: ErrorResponseError{Status: http.StatusTooManyRequests}, expected: true},
+		{name: "not found", err: ErrorResponseError{Status: http.StatusNotFound}, expected: false},
+		{name: "client error", err: NewClientError("failed to execute request", 0, syscall.ECONNRESET), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNetworkRetries_Logging(t *testing.T) {
+	tests := []struct {
+		name         string
+		retryLogging bool
+		wantRecords  int
+	}{
+		{name: "disabled", retryLogging: false, wantRecords: 1},
+		{name: "enabled", retryLogging: true, wantRecords: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			var bodies []string
+
+			logger := &recordingLogger{}
+			client := NewClient("test-api-key").
+				WithHTTPClient(&http.Client{Transport: flakyTransport(2, &attempts, &bodies)}).
+				WithLogger(logger).
+				WithRetryLogging(tt.retryLogging)
+			client.networkRetryBackoff = time.Millisecond
+
+			if _, _, err := client.GetSignatureRequest(context.Background(), "abc123"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(logger.infos) != tt.wantRecords {
+				t.Fatalf("expected %d log records, got %d", tt.wantRecords, len(logger.infos))
+			}
+
+			final := logger.infos[len(logger.infos)-1]
+			if final.Attempt != 3 || final.StatusCode != http.StatusOK || final.RetryDelay != 0 {
+				t.Errorf("unexpected final record: %+v", final)
+			}
+
+			for i, info := range logger.infos[:len(logger.infos)-1] {
+				if info.Attempt != i+1 {
+					t.Errorf("expected attempt %d, got %d", i+1, info.Attempt)
+				}
+				if !errors.Is(info.Err, syscall.ECONNRESET) {
+					t.Errorf("expected connection reset error, got %v", info.Err)
+				}
+				if want := time.Millisecond << i; info.RetryDelay != want {
+					t.Errorf("expected retry delay %v, got %v", want, info.RetryDelay)
+				}
+			}
+		})
+	}
+}
+
+// overloadedTransport answers the first len(statuses) round trips with the
+// given error statuses and the rest with a signature request.
+func overloadedTransport(statuses []int, header http.Header, attempts *int, bodies *[]string) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		*attempts++
+		if r.Body != nil {
+			data, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(data))
+		}
+		if *attempts <= len(statuses) {
+			return &http.Response{
+				StatusCode: statuses[*attempts-1],
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"error": {"error_msg": "Try again later", "error_name": "unavailable"}}`)),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"signature_request": {"signature_request_id": "abc123"}}`)),
+		}, nil
+	})
+}
+
+func TestWithRetry(t *testing.T) {
+	tests := []struct {
+		name           string
+		statuses       []int
+		header         http.Header
+		call           func(ctx context.Context, c *Client) error
+		wantAttempts   int
+		wantRetryCount int
+	}{
+		{
+			name:     "get is retried on service unavailable",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetSignatureRequest(ctx, "abc123")
+				return err
+			},
+			wantAttempts: 3,
+		},
+		{
+			name:     "post is not retried on service unavailable",
+			statuses: []int{http.StatusServiceUnavailable},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.SendWithTemplate(ctx, newValidRequest())
+				return err
+			},
+			wantAttempts: 1,
+		},
+		{
+			name:     "post is retried on rate limit",
+			statuses: []int{http.StatusTooManyRequests},
+			header:   http.Header{"Retry-After": []string{"0"}},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.SendWithTemplate(ctx, newValidRequest())
+				return err
+			},
+			wantAttempts: 2,
+		},
+		{
+			name:     "multipart post is replayed on rate limit",
+			statuses: []int{http.StatusTooManyRequests},
+			header:   http.Header{"Retry-After": []string{"0"}},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.SendWithTemplate(ctx, newValidRequest().WithFiles([][]byte{[]byte("%PDF-1.4")}))
+				return err
+			},
+			wantAttempts: 2,
+		},
+		{
+			name:     "gives up after max retries",
+			statuses: []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetSignatureRequest(ctx, "abc123")
+				return err
+			},
+			wantAttempts:   3,
+			wantRetryCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			var bodies []string
+
+			client := NewClient("test-api-key").
+				WithHTTPClient(&http.Client{Transport: overloadedTransport(tt.statuses, tt.header, &attempts, &bodies)}).
+				WithRetry(2, time.Millisecond)
+
+			err := tt.call(context.Background(), client)
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+			for i := 1; i < len(bodies); i++ {
+				if bodies[i] != bodies[0] {
+					t.Errorf("expected retried body %q, got %q", bodies[0], bodies[i])
+				}
+			}
+
+			succeeded := attempts > len(tt.statuses)
+			if succeeded != (err == nil) {
+				t.Fatalf("expected success %v, got %v", succeeded, err)
+			}
+			if tt.wantRetryCount > 0 {
+				apiErr, ok := err.(ErrorResponseError)
+				if !ok || apiErr.RetryCount != tt.wantRetryCount || apiErr.Status != http.StatusGatewayTimeout {
+					t.Errorf("expected a 504 ErrorResponseError with %d retries, got %#v", tt.wantRetryCount, err)
+				}
+			}
+		})
+	}
+}
+
+func TestWithRetry_KeepsRateLimitError(t *testing.T) {
+	var attempts int
+	var bodies []string
+
+	client := NewClient("test-api-key").
+		WithHTTPClient(&http.Client{Transport: overloadedTransport([]int{http.StatusTooManyRequests, http.StatusTooManyRequests}, http.Header{"Retry-After": []string{"0"}}, &attempts, &bodies)}).
+		WithRetry(1, time.Millisecond)
+
+	_, _, err := client.GetSignatureRequest(context.Background(), "abc123")
+	rateLimitErr, ok := err.(RateLimitError)
+	if !ok {
+		t.Fatalf("expected a RateLimitError, got %#v", err)
+	}
+	if rateLimitErr.RetryCount != 1 {
+		t.Errorf("expected 1 retry, got %d", rateLimitErr.RetryCount)
+	}
+}
+
+func TestStatusRetryDelay_Capped(t *testing.T) {
+	client := NewClient("test-api-key").WithRetry(1000, time.Second)
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable}
+
+	for _, retry := range []int{1, 10, 64, 1000} {
+		delay := client.statusRetryDelay(resp, nil, retry)
+		if delay <= 0 || delay > maxRetryBackoff {
+			t.Errorf("retry %d: expected a delay in (0, %v], got %v", retry, maxRetryBackoff, delay)
+		}
+	}
+}
+
+func TestWithRetry_ContextCanceled(t *testing.T) {
+	var attempts int
+	var bodies []string
+
+	client := NewClient("test-api-key").
+		WithHTTPClient(&http.Client{Transport: overloadedTransport([]int{http.StatusTooManyRequests}, http.Header{"Retry-After": []string{"3600"}}, &attempts, &bodies)}).
+		WithRetry(2, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.GetSignatureRequest(ctx, "abc123")
+	if !IsRateLimited(err) {
+		t.Errorf("expected the rate limit error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value     string
+		wantDelay time.Duration
+		wantOK    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-5", 0, true},
+		{"Mon, 01 Jan 2024 12:00:30 GMT", 30 * time.Second, true},
+		{"Mon, 01 Jan 2024 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		delay, ok := parseRetryAfter(tt.value, now)
+		if delay != tt.wantDelay || ok != tt.wantOK {
+			t.Errorf("parseRetryAfter(%q): expected %v %v, got %v %v", tt.value, tt.wantDelay, tt.wantOK, delay, ok)
+		}
+	}
+}
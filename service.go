@@ -0,0 +1,105 @@
+package dropboxsign
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// SignatureService is the set of signature request operations provided by Client.
+//
+// Depend on this interface instead of *Client to substitute a fake in tests,
+// such as the in-memory implementation in the fakeclient subpackage. It covers
+// the operations that map directly to API endpoints; convenience helpers such
+// as RemindAllPending or ExtendExpiration are built on top of them and remain
+// methods of Client.
+type SignatureService interface {
+	// GetSignatureRequest retrieves a signature request by its ID
+	GetSignatureRequest(ctx context.Context, signatureRequestID string) (*SignatureRequestResponse, []WarningResponse, error)
+	// ListSignatureRequests retrieves a page of signature requests
+	ListSignatureRequests(ctx context.Context, opts *ListSignatureRequestsOptions) (*SignatureRequestListResponse, []WarningResponse, error)
+	// SendWithTemplate sends a signature request using a template
+	SendWithTemplate(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error)
+	// CreateEmbeddedWithTemplate creates a signature request for embedded signing using a template
+	CreateEmbeddedWithTemplate(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error)
+	// UpdateSignatureRequest updates a signer or the expiration of a signature request
+	UpdateSignatureRequest(ctx context.Context, signatureRequestID string, request *UpdateSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error)
+	// RemindSignatureRequest sends an email reminder to a signer
+	RemindSignatureRequest(ctx context.Context, signatureRequestID, emailAddress string, opts ...RemindOption) (*SignatureRequestResponse, []WarningResponse, error)
+	// CancelIncompleteSignatureRequest cancels an incomplete signature request
+	CancelIncompleteSignatureRequest(ctx context.Context, signatureRequestID string) error
+}
+
+var _ SignatureService = (*Client)(nil)
+
+// API is the complete set of operations provided by Client, including the
+// convenience helpers built on top of SignatureService.
+//
+// Depend on API when your code needs more than SignatureService, and
+// substitute your own implementation in tests. Client keeps its name as a
+// concrete type so that existing code and the With* configuration chain keep
+// working; the interface captures its operations, not its configuration.
+type API interface {
+	SignatureService
+
+	// RemindAllPending sends a reminder to every signer whose signature is still awaited
+	RemindAllPending(ctx context.Context, signatureRequestID string) error
+	// RemindSignatureRequests sends reminders for many signers concurrently
+	RemindSignatureRequests(ctx context.Context, reminders []ReminderTarget, concurrency int) map[string]error
+	// ExtendExpiration moves the expiration of a signature request to a later time
+	ExtendExpiration(ctx context.Context, signatureRequestID string, newExpiry time.Time) error
+	// CreateEmbeddedWithTemplateAndURLs creates an embedded signature request and fetches every signing URL
+	CreateEmbeddedWithTemplateAndURLs(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, map[string]string, []WarningResponse, error)
+	// GetEmbeddedSignURL retrieves the embedded signing URL for a signature
+	GetEmbeddedSignURL(ctx context.Context, signatureID string) (*EmbeddedResponse, []WarningResponse, error)
+	// UpdateTemplateFiles replaces the documents of an existing template in place
+	UpdateTemplateFiles(ctx context.Context, templateID string, files [][]byte) error
+	// GetSignatureRequestWithResponse is GetSignatureRequest, also returning the HTTP response
+	GetSignatureRequestWithResponse(ctx context.Context, signatureRequestID string) (*ResponseWithWarnings[SignatureRequestResponse], error)
+	// ListSignatureRequestsWithResponse is ListSignatureRequests, also returning the HTTP response
+	ListSignatureRequestsWithResponse(ctx context.Context, opts *ListSignatureRequestsOptions) (*ResponseWithWarnings[SignatureRequestListResponse], error)
+	// SendWithTemplateWithResponse is SendWithTemplate, also returning the HTTP response
+	SendWithTemplateWithResponse(ctx context.Context, request *SendSignatureRequest) (*ResponseWithWarnings[SignatureRequestResponse], error)
+	// ResendWithChanges replaces a signature request with a corrected copy
+	ResendWithChanges(ctx context.Context, original *SignatureRequestResponse, apply func(*SendSignatureRequest)) (*SignatureRequestResponse, []WarningResponse, error)
+	// CreateReport requests one or more reports covering a date range
+	CreateReport(ctx context.Context, request *CreateReportRequest) (*ReportResponse, []WarningResponse, error)
+	// GetAccount retrieves the account that owns the API key
+	GetAccount(ctx context.Context) (*AccountResponse, []WarningResponse, error)
+	// UpdateAccount updates the settings of an account
+	UpdateAccount(ctx context.Context, request *UpdateAccountRequest) (*AccountResponse, []WarningResponse, error)
+	// GetTemplate retrieves a template by its ID
+	GetTemplate(ctx context.Context, templateID string) (*TemplateResponse, []WarningResponse, error)
+	// WarmTemplateCache fetches templates concurrently into the template cache
+	WarmTemplateCache(ctx context.Context, templateIDs []string) map[string]error
+	// CancelByMetadata cancels every incomplete signature request with the given metadata value
+	CancelByMetadata(ctx context.Context, key, value string) ([]string, map[string]error, error)
+	// GetSignatureRequestByCustomID retrieves the signature request with the given custom ID
+	GetSignatureRequestByCustomID(ctx context.Context, customID string) (*SignatureRequestResponse, error)
+	// EnsureSent sends a signature request unless one with the given custom ID was already sent
+	EnsureSent(ctx context.Context, customID string, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error)
+	// DownloadFilesTo streams the files of a signature request to a writer
+	DownloadFilesTo(ctx context.Context, signatureRequestID string, w io.Writer, opts DownloadOptions) (string, error)
+	// DownloadFiles opens the files of a signature request for reading
+	DownloadFiles(ctx context.Context, signatureRequestID string, fileType FileType) (io.ReadCloser, string, error)
+	// DownloadFilesAsDataURI returns the files of a signature request as a base64 data URI
+	DownloadFilesAsDataURI(ctx context.Context, signatureRequestID string) (string, []WarningResponse, error)
+	// DownloadFilesWhenReady waits for the files of a signature request to be ready, then streams them to a writer
+	DownloadFilesWhenReady(ctx context.Context, signatureRequestID string, w io.Writer, opts DownloadOptions) (string, error)
+	// GetBulkSendJob retrieves a bulk send job and a page of its signature requests
+	GetBulkSendJob(ctx context.Context, bulkSendJobID string, opts *BulkSendJobOptions) (*BulkSendJobGetResponse, []WarningResponse, error)
+	// GetBulkSendJobProgress summarizes the progress of a bulk send job across all pages
+	GetBulkSendJobProgress(ctx context.Context, bulkSendJobID string) (*BulkProgress, error)
+	// Ping checks that the API is reachable and the API key is valid
+	Ping(ctx context.Context) error
+	// Send sends a signature request for documents given as files or file URLs
+	Send(ctx context.Context, request *SendSignatureRequest) (*SignatureRequestResponse, []WarningResponse, error)
+	// RemoveSignatureRequestAccess permanently removes access to a signature request and its files
+	RemoveSignatureRequestAccess(ctx context.Context, signatureRequestID string) error
+	// ListTemplates retrieves a page of templates
+	ListTemplates(ctx context.Context, opts *ListTemplateOptions) (*TemplateListResponse, []WarningResponse, error)
+	// Close releases idle connections and cached data held by the client
+	Close() error
+}
+
+var _ API = (*Client)(nil)
@@ -3,7 +3,12 @@ package dropboxsign
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // SendSignatureRequest represents a request structure for sending signature requests with templates.
@@ -26,19 +31,30 @@ import (
 type SendSignatureRequest struct {
 	// Signers is the list of signers who will receive the signature request
 	Signers []SubSignatureRequestTemplateSigner `json:"signers"`
-	// TemplateIDs is the list of template IDs to use for this signature request
+	// TemplateIDs is the list of template IDs to use for this signature request.
+	// Surrounding whitespace is trimmed before the request is sent.
 	TemplateIDs []string `json:"template_ids"`
 	// AllowDecline specifies whether signers can decline to sign (default: true)
 	AllowDecline *bool `json:"allow_decline,omitempty"`
+	// Attachments are the files signers are asked to upload, such as a photo ID.
+	// The API only honors attachments for requests sent with files.
+	Attachments []SubAttachment `json:"attachments,omitempty"`
 	// CCs is the list of CC recipients who will receive copies of the signature request
 	CCs []SubCC `json:"ccs,omitempty"`
 	// ClientID is the client ID for API apps
 	ClientID *string `json:"client_id,omitempty"`
 	// CustomFields are custom form fields to pre-populate in the document
 	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
-	// Files is file data as byte arrays (alternative to FileURLs)
-	Files [][]byte `json:"files,omitempty"`
-	// FileURLs are URLs to files to be signed (alternative to Files)
+	// Files is file data as byte arrays (alternative to FileURLs). With templates,
+	// these are supplemental documents appended after the template documents.
+	// Setting Files sends the request as multipart/form-data.
+	Files [][]byte `json:"-"`
+	// FileNames are the names of the uploaded Files, in the same order; the
+	// API shows them to signers and goes by their extension for the file type.
+	// Files without a name get one derived from their content (see WithFileNames).
+	FileNames []string `json:"-"`
+	// FileURLs are URLs to files to be signed (alternative to Files). With
+	// templates, these are supplemental documents appended after the template documents.
 	FileURLs []string `json:"file_urls,omitempty"`
 	// IsEID specifies whether to enable eIDAS compliance (European electronic signatures)
 	IsEID *bool `json:"is_eid,omitempty"`
@@ -50,6 +66,11 @@ type SendSignatureRequest struct {
 	SigningOptions *SubSigningOptions `json:"signing_options,omitempty"`
 	// SigningRedirectURL is the URL to redirect signers to after completing their signature
 	SigningRedirectURL *string `json:"signing_redirect_url,omitempty"`
+	// Subject is the subject line of the signature request email
+	Subject *string `json:"subject,omitempty"`
+	// PopulateAutoFillFields specifies whether fields are auto-filled from the
+	// signer's profile; only honored by CreateEmbeddedWithTemplate
+	PopulateAutoFillFields *bool `json:"populate_auto_fill_fields,omitempty"`
 	// TestMode specifies whether to create the signature request in test mode
 	TestMode *bool `json:"test_mode,omitempty"`
 	// Title is the title for the signature request
@@ -72,12 +93,49 @@ func NewSendSignatureRequest(signers []SubSignatureRequestTemplateSigner, templa
 	}
 }
 
+// NewSendSignatureRequestWithFiles creates a new signature request for
+// documents uploaded as files rather than based on a template, for use with
+// Client.Send.
+//
+// The signers' Role is not used without a template and may be left empty.
+//
+// Example:
+//
+//	signer := dropboxsign.NewSubSignatureRequestTemplateSigner("", "Jane Doe", "jane@example.com")
+//	request := dropboxsign.NewSendSignatureRequestWithFiles(
+//		[]dropboxsign.SubSignatureRequestTemplateSigner{signer},
+//		[][]byte{contractPDF},
+//	).WithTitle("Contract")
+func NewSendSignatureRequestWithFiles(signers []SubSignatureRequestTemplateSigner, files [][]byte) *SendSignatureRequest {
+	return &SendSignatureRequest{
+		Signers: signers,
+		Files:   files,
+	}
+}
+
 // WithAllowDecline sets whether signers can decline to sign the document.
 func (s *SendSignatureRequest) WithAllowDecline(allowDecline bool) *SendSignatureRequest {
 	s.AllowDecline = &allowDecline
 	return s
 }
 
+// WithAttachments sets the files signers are asked to upload while signing.
+//
+// The API only honors attachments for requests sent with files; requests
+// based on a template use the attachments defined in the template.
+//
+// Example:
+//
+//	request.WithAttachments([]dropboxsign.SubAttachment{
+//		dropboxsign.NewSubAttachment("Photo ID", 0).
+//			WithInstructions("Upload a photo of your passport or driving licence").
+//			WithRequired(true),
+//	})
+func (s *SendSignatureRequest) WithAttachments(attachments []SubAttachment) *SendSignatureRequest {
+	s.Attachments = attachments
+	return s
+}
+
 // WithCCs sets the list of CC recipients for the signature request.
 func (s *SendSignatureRequest) WithCCs(ccs []SubCC) *SendSignatureRequest {
 	s.CCs = ccs
@@ -97,11 +155,30 @@ func (s *SendSignatureRequest) WithCustomFields(customFields []SubCustomField) *
 }
 
 // WithFiles sets file data as byte arrays for documents to be signed.
+//
+// When sending with a template, the files are appended after the template
+// documents. Files cannot be combined with FileURLs.
 func (s *SendSignatureRequest) WithFiles(files [][]byte) *SendSignatureRequest {
 	s.Files = files
 	return s
 }
 
+// WithFileNames sets the names of the uploaded Files, in the same order.
+//
+// Signers see the file names, and the API determines each file's type from
+// its extension. Files without a name are named "file0.pdf", "file1.png"
+// and so on, with the extension derived from their content; Word and other
+// Office documents cannot be recognized that way and need a name.
+//
+// Example:
+//
+//	request := dropboxsign.NewSendSignatureRequestWithFiles(signers, [][]byte{contract, terms}).
+//		WithFileNames("contract.docx", "terms.pdf")
+func (s *SendSignatureRequest) WithFileNames(names ...string) *SendSignatureRequest {
+	s.FileNames = names
+	return s
+}
+
 // WithFileURLs sets URLs to files that should be downloaded and used as documents.
 func (s *SendSignatureRequest) WithFileURLs(fileURLs []string) *SendSignatureRequest {
 	s.FileURLs = fileURLs
@@ -115,6 +192,9 @@ func (s *SendSignatureRequest) WithIsEID(isEID bool) *SendSignatureRequest {
 }
 
 // WithMessage sets a custom message to include in signature request emails.
+//
+// Use ValidateMergeFields to check {field_name} placeholders in the message
+// against the template's custom fields.
 func (s *SendSignatureRequest) WithMessage(message string) *SendSignatureRequest {
 	s.Message = &message
 	return s
@@ -126,6 +206,23 @@ func (s *SendSignatureRequest) WithMetadata(metadata map[string]string) *SendSig
 	return s
 }
 
+// WithSequentialSigning makes signers sign one after another in slice order
+// by assigning each signer an Order of 0 through n-1, replacing any existing order.
+//
+// The API only honors signer order for requests sent with files; requests
+// based on a template follow the signing order defined in the template.
+// Signer groups are not modeled by this package: a group counts as a single
+// position in the order, so its members must not be listed as separate signers.
+//
+// Call it after the signers are final, since signers added later get no order.
+func (s *SendSignatureRequest) WithSequentialSigning() *SendSignatureRequest {
+	for i := range s.Signers {
+		order := i
+		s.Signers[i].Order = &order
+	}
+	return s
+}
+
 // WithSigningOptions sets configuration for available signature methods.
 func (s *SendSignatureRequest) WithSigningOptions(signingOptions *SubSigningOptions) *SendSignatureRequest {
 	s.SigningOptions = signingOptions
@@ -138,6 +235,26 @@ func (s *SendSignatureRequest) WithSigningRedirectURL(signingRedirectURL string)
 	return s
 }
 
+// WithSubject sets the subject line of the signature request email.
+//
+// When unset, the subject defined by the template is used.
+// Use ValidateMergeFields to check {field_name} placeholders in the subject
+// against the template's custom fields.
+func (s *SendSignatureRequest) WithSubject(subject string) *SendSignatureRequest {
+	s.Subject = &subject
+	return s
+}
+
+// WithPopulateAutoFillFields sets whether fields such as the signer's name,
+// email address and date signed are filled in automatically.
+//
+// The API only honors this for embedded requests created with
+// CreateEmbeddedWithTemplate.
+func (s *SendSignatureRequest) WithPopulateAutoFillFields(populate bool) *SendSignatureRequest {
+	s.PopulateAutoFillFields = &populate
+	return s
+}
+
 // WithTestMode sets whether to create the signature request in test mode.
 func (s *SendSignatureRequest) WithTestMode(testMode bool) *SendSignatureRequest {
 	s.TestMode = &testMode
@@ -150,6 +267,149 @@ func (s *SendSignatureRequest) WithTitle(title string) *SendSignatureRequest {
 	return s
 }
 
+// Clone returns a deep copy of the request, so that variants can be derived
+// from a shared base request without changes to one, such as to its Metadata
+// map or Signers slice, showing up in the others.
+//
+// The contents of Files are shared rather than copied, since the request
+// never modifies them.
+//
+// Example:
+//
+//	for _, recipient := range recipients {
+//		request := base.Clone().WithTitle("Contract for " + recipient.Name)
+//		request.Signers[0].Name = recipient.Name
+//		request.Signers[0].EmailAddress = recipient.Email
+//		request.Metadata["recipient_id"] = recipient.ID
+//		// send request
+//	}
+func (s *SendSignatureRequest) Clone() *SendSignatureRequest {
+	clone := *s
+
+	if s.Signers != nil {
+		clone.Signers = make([]SubSignatureRequestTemplateSigner, len(s.Signers))
+		for i, signer := range s.Signers {
+			signer.Pin = clonePtr(signer.Pin)
+			signer.SMSPhoneNumber = clonePtr(signer.SMSPhoneNumber)
+			signer.SMSPhoneNumberType = clonePtr(signer.SMSPhoneNumberType)
+			signer.Order = clonePtr(signer.Order)
+			clone.Signers[i] = signer
+		}
+	}
+	if s.Attachments != nil {
+		clone.Attachments = make([]SubAttachment, len(s.Attachments))
+		for i, attachment := range s.Attachments {
+			attachment.Instructions = clonePtr(attachment.Instructions)
+			attachment.Required = clonePtr(attachment.Required)
+			clone.Attachments[i] = attachment
+		}
+	}
+	if s.CustomFields != nil {
+		clone.CustomFields = make([]SubCustomField, len(s.CustomFields))
+		for i, field := range s.CustomFields {
+			field.Editor = clonePtr(field.Editor)
+			field.Required = clonePtr(field.Required)
+			field.Value = clonePtr(field.Value)
+			field.RequiredIf = clonePtr(field.RequiredIf)
+			clone.CustomFields[i] = field
+		}
+	}
+	if s.Metadata != nil {
+		clone.Metadata = make(map[string]string, len(s.Metadata))
+		for key, value := range s.Metadata {
+			clone.Metadata[key] = value
+		}
+	}
+	if s.SigningOptions != nil {
+		options := *s.SigningOptions
+		options.Draw = clonePtr(options.Draw)
+		options.Phone = clonePtr(options.Phone)
+		options.Type = clonePtr(options.Type)
+		options.Upload = clonePtr(options.Upload)
+		clone.SigningOptions = &options
+	}
+
+	clone.TemplateIDs = cloneSlice(s.TemplateIDs)
+	clone.CCs = cloneSlice(s.CCs)
+	clone.Files = cloneSlice(s.Files)
+	clone.FileNames = cloneSlice(s.FileNames)
+	clone.FileURLs = cloneSlice(s.FileURLs)
+
+	clone.AllowDecline = clonePtr(s.AllowDecline)
+	clone.ClientID = clonePtr(s.ClientID)
+	clone.IsEID = clonePtr(s.IsEID)
+	clone.Message = clonePtr(s.Message)
+	clone.SigningRedirectURL = clonePtr(s.SigningRedirectURL)
+	clone.Subject = clonePtr(s.Subject)
+	clone.PopulateAutoFillFields = clonePtr(s.PopulateAutoFillFields)
+	clone.TestMode = clonePtr(s.TestMode)
+	clone.Title = clonePtr(s.Title)
+	return &clone
+}
+
+// clonePtr returns a pointer to a copy of the value p points to, or nil.
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// cloneSlice returns a shallow copy of s, preserving nil.
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+// UpdateSignatureRequest represents a request to update a signer or the
+// expiration of a signature request.
+//
+// Only the fields that are set are sent, so unset fields are left unchanged.
+//
+// Example:
+//
+//	request := dropboxsign.NewUpdateSignatureRequest("signature_id").
+//		WithEmailAddress("new@example.com")
+type UpdateSignatureRequest struct {
+	// SignatureID is the ID of the signature to update
+	SignatureID string `json:"signature_id"`
+	// EmailAddress is the new email address for the signer
+	EmailAddress *string `json:"email_address,omitempty"`
+	// Name is the new name for the signer
+	Name *string `json:"name,omitempty"`
+	// ExpiresAt is the new Unix timestamp at which the signature request expires
+	ExpiresAt *int64 `json:"expires_at,omitempty"`
+}
+
+// NewUpdateSignatureRequest creates a new update request for the given signature ID.
+func NewUpdateSignatureRequest(signatureID string) *UpdateSignatureRequest {
+	return &UpdateSignatureRequest{
+		SignatureID: signatureID,
+	}
+}
+
+// WithEmailAddress sets the new email address for the signer.
+func (u *UpdateSignatureRequest) WithEmailAddress(emailAddress string) *UpdateSignatureRequest {
+	u.EmailAddress = &emailAddress
+	return u
+}
+
+// WithName sets the new name for the signer.
+func (u *UpdateSignatureRequest) WithName(name string) *UpdateSignatureRequest {
+	u.Name = &name
+	return u
+}
+
+// WithExpiresAt sets the new expiration time of the signature request.
+func (u *UpdateSignatureRequest) WithExpiresAt(expiresAt time.Time) *UpdateSignatureRequest {
+	unix := expiresAt.Unix()
+	u.ExpiresAt = &unix
+	return u
+}
+
 // SubSignatureRequestTemplateSigner represents a signer in a template-based signature request.
 //
 // Each signer must have a role (matching the template), name, and email address.
@@ -167,10 +427,15 @@ type SubSignatureRequestTemplateSigner struct {
 	SMSPhoneNumber *string `json:"sms_phone_number,omitempty"`
 	// SMSPhoneNumberType is the type of SMS usage (authentication or delivery)
 	SMSPhoneNumberType *SMSPhoneNumberType `json:"sms_phone_number_type,omitempty"`
+	// Order is the zero-based position of the signer in a sequential signing workflow
+	Order *int `json:"order,omitempty"`
 }
 
 // NewSubSignatureRequestTemplateSigner creates a new signer with the minimum required information.
 //
+// emailAddress must be a bare address such as "jane@example.com", without a
+// display name; SendSignatureRequest.Validate reports addresses that are not.
+//
 // Example:
 //
 //	signer := dropboxsign.NewSubSignatureRequestTemplateSigner(
@@ -187,6 +452,8 @@ func NewSubSignatureRequestTemplateSigner(role, name, emailAddress string) SubSi
 }
 
 // WithPin sets a PIN that the signer must enter before signing.
+//
+// The API requires 4 to 12 digits; Validate reports PINs that are not.
 func (s SubSignatureRequestTemplateSigner) WithPin(pin string) SubSignatureRequestTemplateSigner {
 	s.Pin = &pin
 	return s
@@ -204,6 +471,26 @@ func (s SubSignatureRequestTemplateSigner) WithSMSPhoneNumberType(smsPhoneNumber
 	return s
 }
 
+// WithOrder sets the zero-based position of the signer in a sequential signing workflow.
+func (s SubSignatureRequestTemplateSigner) WithOrder(order int) SubSignatureRequestTemplateSigner {
+	s.Order = &order
+	return s
+}
+
+// String returns a concise summary of the signer with the email address and
+// phone number masked, so signers can be logged safely. The PIN is never included.
+func (s SubSignatureRequestTemplateSigner) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "role=%s email=%s", s.Role, maskEmail(s.EmailAddress))
+	if s.Pin != nil {
+		b.WriteString(" pin=set")
+	}
+	if s.SMSPhoneNumber != nil {
+		fmt.Fprintf(&b, " sms=%s", maskPhoneNumber(*s.SMSPhoneNumber))
+	}
+	return b.String()
+}
+
 // SMSPhoneNumberType specifies how SMS phone numbers are used in signature requests.
 type SMSPhoneNumberType string
 
@@ -214,18 +501,60 @@ const (
 	SMSPhoneNumberTypeDelivery SMSPhoneNumberType = "delivery"
 )
 
+// SubAttachment represents a file a signer is asked to upload as part of
+// signing, such as a photo ID.
+type SubAttachment struct {
+	// Name is the name of the attachment shown to the signer
+	Name string `json:"name"`
+	// SignerIndex is the index in Signers of the signer asked to upload the attachment
+	SignerIndex int `json:"signer_index"`
+	// Instructions tells the signer what to upload
+	Instructions *string `json:"instructions,omitempty"`
+	// Required specifies whether the signer must upload the attachment to finish signing
+	Required *bool `json:"required,omitempty"`
+}
+
+// NewSubAttachment creates a new attachment request for the signer at
+// signerIndex in the request's Signers.
+func NewSubAttachment(name string, signerIndex int) SubAttachment {
+	return SubAttachment{
+		Name:        name,
+		SignerIndex: signerIndex,
+	}
+}
+
+// WithInstructions sets the instructions shown to the signer.
+func (s SubAttachment) WithInstructions(instructions string) SubAttachment {
+	s.Instructions = &instructions
+	return s
+}
+
+// WithRequired sets whether the signer must upload the attachment.
+func (s SubAttachment) WithRequired(required bool) SubAttachment {
+	s.Required = &required
+	return s
+}
+
 // SubCC represents a carbon copy recipient for signature requests.
 //
 // CC recipients receive copies of signature request emails and completion notifications
 // but are not required to sign the document.
+//
+// A role and an email address are all the API accepts for a CC; it has no
+// option to limit which events a CC is notified of. To notify someone only
+// once every signer has signed, leave them off the request and send them the
+// files when the signature_request_all_signed callback arrives.
 type SubCC struct {
 	// Role is the role name for the CC recipient (must match template if using templates)
 	Role string `json:"role"`
 	// Email is the email address of the CC recipient
-	Email string `json:"email"`
+	Email string `json:"email_address"`
 }
 
 // NewSubCC creates a new CC recipient.
+//
+// email must be a bare address without a display name; SendSignatureRequest.Validate
+// reports addresses that are not.
 func NewSubCC(role, email string) SubCC {
 	return SubCC{
 		Role:  role,
@@ -246,6 +575,9 @@ type SubCustomField struct {
 	Required *bool `json:"required,omitempty"`
 	// Value is the default value for the field
 	Value *string `json:"value,omitempty"`
+	// RequiredIf is the name of a checkbox field that makes this field required when checked.
+	// It is only used for client-side validation and is not sent to the API.
+	RequiredIf *string `json:"-"`
 }
 
 // NewSubCustomField creates a new custom field with the specified name.
@@ -273,6 +605,23 @@ func (s SubCustomField) WithValue(value string) SubCustomField {
 	return s
 }
 
+// WithRequiredIf marks this field as required whenever the checkbox field
+// named otherFieldName is checked.
+//
+// The dependency is checked by SendSignatureRequest.Validate against the
+// pre-populated values; the API itself has no notion of it.
+//
+// Example:
+//
+//	customFields := []dropboxsign.SubCustomField{
+//		dropboxsign.NewSubCustomField("married").WithValue("true"),
+//		dropboxsign.NewSubCustomField("spouse_name").WithRequiredIf("married"),
+//	}
+func (s SubCustomField) WithRequiredIf(otherFieldName string) SubCustomField {
+	s.RequiredIf = &otherFieldName
+	return s
+}
+
 // SubSigningOptions represents configuration for available signature methods.
 //
 // Defines which signature methods are available to signers and which one
@@ -321,6 +670,34 @@ func (s *SubSigningOptions) WithUpload(upload bool) *SubSigningOptions {
 	return s
 }
 
+// Validate checks that DefaultType is one of the known signature methods and
+// that the default method has not been explicitly disabled.
+//
+// An empty DefaultType is rejected by the API with an unhelpful error, so it
+// is reported here instead.
+func (s *SubSigningOptions) Validate() error {
+	var enabled *bool
+	switch s.DefaultType {
+	case SubSigningOptionsDefaultTypeDraw:
+		enabled = s.Draw
+	case SubSigningOptionsDefaultTypePhone:
+		enabled = s.Phone
+	case SubSigningOptionsDefaultTypeType:
+		enabled = s.Type
+	case SubSigningOptionsDefaultTypeUpload:
+		enabled = s.Upload
+	case "":
+		return errors.New("signing_options.default_type is required")
+	default:
+		return fmt.Errorf("signing_options.default_type %q must be one of draw, phone, type or upload", s.DefaultType)
+	}
+
+	if enabled != nil && !*enabled {
+		return fmt.Errorf("signing_options.default_type %q is disabled by signing_options.%s", s.DefaultType, s.DefaultType)
+	}
+	return nil
+}
+
 // SubSigningOptionsDefaultType represents available signature methods for the default signing option.
 type SubSigningOptionsDefaultType string
 
@@ -354,7 +731,9 @@ type SignatureRequestResponse struct {
 	Subject *string `json:"subject,omitempty"`
 	// Message is the custom message included in signature request emails
 	Message *string `json:"message,omitempty"`
-	// Metadata contains custom metadata key-value pairs
+	// Metadata contains custom metadata key-value pairs. The API does not
+	// report whether a request was created through the API or the web app;
+	// set a metadata key on API sends to tell them apart.
 	Metadata map[string]string `json:"metadata"`
 	// CreatedAt is the Unix timestamp when the signature request was created
 	CreatedAt int64 `json:"created_at"`
@@ -366,9 +745,12 @@ type SignatureRequestResponse struct {
 	IsDeclined bool `json:"is_declined"`
 	// HasError indicates whether there are any errors with this signature request
 	HasError bool `json:"has_error"`
+	// AllowReassign indicates whether signers may reassign their signature to someone else (if returned)
+	AllowReassign *bool `json:"allow_reassign,omitempty"`
 	// FilesURL is the URL to download the signed documents
 	FilesURL string `json:"files_url"`
-	// SigningURL is the URL for signers to access the signing interface
+	// SigningURL is the URL for signers to access the signing interface.
+	// It is nil for embedded signature requests; see EmbeddedSignatureIDs.
 	SigningURL *string `json:"signing_url,omitempty"`
 	// DetailsURL is the URL to view signature request details
 	DetailsURL string `json:"details_url"`
@@ -382,14 +764,437 @@ type SignatureRequestResponse struct {
 	TemplateIDs []string `json:"template_ids,omitempty"`
 	// CustomIDs are custom IDs associated with this signature request
 	CustomIDs []string `json:"custom_ids,omitempty"`
+	// CustomFields are the custom form fields and their values for this signature request
+	CustomFields []SignatureRequestResponseCustomFieldBase `json:"custom_fields,omitempty"`
 	// Attachments are file attachments associated with this signature request
-	Attachments *SignatureRequestResponseAttachment `json:"attachments,omitempty"`
+	Attachments []SignatureRequestResponseAttachment `json:"attachments,omitempty"`
 	// ResponseData contains form field response data from signers
 	ResponseData []SignatureRequestResponseData `json:"response_data,omitempty"`
 	// Signatures contains individual signature status for each signer
 	Signatures []SignatureRequestResponseSignatures `json:"signatures"`
 	// BulkSendJobID is the bulk send job ID if this was part of a bulk operation
 	BulkSendJobID *string `json:"bulk_send_job_id,omitempty"`
+	// IsEmbedded indicates the request was created for embedded signing. The API
+	// does not return this; it is set on responses from CreateEmbeddedWithTemplate.
+	IsEmbedded bool `json:"-"`
+}
+
+// CreatedAtTime returns CreatedAt as a time.Time.
+func (r *SignatureRequestResponse) CreatedAtTime() time.Time {
+	return time.Unix(r.CreatedAt, 0)
+}
+
+// ExpiresAtTime returns ExpiresAt as a time.Time, or nil if the signature
+// request does not expire.
+func (r *SignatureRequestResponse) ExpiresAtTime() *time.Time {
+	return unixTime(r.ExpiresAt)
+}
+
+// Clone returns a deep copy of the signature request, so that a copy can be
+// modified or handed out without changes showing up in the original.
+func (r *SignatureRequestResponse) Clone() *SignatureRequestResponse {
+	clone := *r
+
+	if r.Metadata != nil {
+		clone.Metadata = make(map[string]string, len(r.Metadata))
+		for key, value := range r.Metadata {
+			clone.Metadata[key] = value
+		}
+	}
+	if r.CustomFields != nil {
+		clone.CustomFields = make([]SignatureRequestResponseCustomFieldBase, len(r.CustomFields))
+		for i, field := range r.CustomFields {
+			field.Required = clonePtr(field.Required)
+			field.APIID = clonePtr(field.APIID)
+			field.Editor = clonePtr(field.Editor)
+			field.Value = clonePtr(field.Value)
+			clone.CustomFields[i] = field
+		}
+	}
+	if r.Attachments != nil {
+		clone.Attachments = make([]SignatureRequestResponseAttachment, len(r.Attachments))
+		for i, attachment := range r.Attachments {
+			attachment.Instructions = clonePtr(attachment.Instructions)
+			attachment.UploadedAt = clonePtr(attachment.UploadedAt)
+			clone.Attachments[i] = attachment
+		}
+	}
+	if r.ResponseData != nil {
+		clone.ResponseData = make([]SignatureRequestResponseData, len(r.ResponseData))
+		for i, data := range r.ResponseData {
+			data.APIID = clonePtr(data.APIID)
+			data.SignatureID = clonePtr(data.SignatureID)
+			data.Name = clonePtr(data.Name)
+			data.Required = clonePtr(data.Required)
+			data.Type = clonePtr(data.Type)
+			data.Value = clonePtr(data.Value)
+			clone.ResponseData[i] = data
+		}
+	}
+	if r.Signatures != nil {
+		clone.Signatures = make([]SignatureRequestResponseSignatures, len(r.Signatures))
+		for i, signature := range r.Signatures {
+			signature.SignerGroupGUID = clonePtr(signature.SignerGroupGUID)
+			signature.SignerName = clonePtr(signature.SignerName)
+			signature.SignerRole = clonePtr(signature.SignerRole)
+			signature.Order = clonePtr(signature.Order)
+			signature.DeclineReason = clonePtr(signature.DeclineReason)
+			signature.SignedAt = clonePtr(signature.SignedAt)
+			signature.LastViewedAt = clonePtr(signature.LastViewedAt)
+			signature.LastRemindedAt = clonePtr(signature.LastRemindedAt)
+			signature.HasSMSAuth = clonePtr(signature.HasSMSAuth)
+			signature.HasSMSDelivery = clonePtr(signature.HasSMSDelivery)
+			signature.SMSPhoneNumber = clonePtr(signature.SMSPhoneNumber)
+			signature.ReassignedBy = clonePtr(signature.ReassignedBy)
+			signature.ReassignmentReason = clonePtr(signature.ReassignmentReason)
+			signature.ReassignedFrom = clonePtr(signature.ReassignedFrom)
+			signature.Error = clonePtr(signature.Error)
+			clone.Signatures[i] = signature
+		}
+	}
+
+	clone.CCEmailAddresses = cloneSlice(r.CCEmailAddresses)
+	clone.TemplateIDs = cloneSlice(r.TemplateIDs)
+	clone.CustomIDs = cloneSlice(r.CustomIDs)
+
+	clone.TestMode = clonePtr(r.TestMode)
+	clone.RequesterEmailAddress = clonePtr(r.RequesterEmailAddress)
+	clone.Subject = clonePtr(r.Subject)
+	clone.Message = clonePtr(r.Message)
+	clone.ExpiresAt = clonePtr(r.ExpiresAt)
+	clone.AllowReassign = clonePtr(r.AllowReassign)
+	clone.SigningURL = clonePtr(r.SigningURL)
+	clone.SigningRedirectURL = clonePtr(r.SigningRedirectURL)
+	clone.FinalCopyURI = clonePtr(r.FinalCopyURI)
+	clone.BulkSendJobID = clonePtr(r.BulkSendJobID)
+	return &clone
+}
+
+// ViewedButNotSigned returns the signatures whose signer has viewed the
+// signature request but is still awaiting signature.
+//
+// Signers who declined are not included.
+func (r *SignatureRequestResponse) ViewedButNotSigned() []SignatureRequestResponseSignatures {
+	var signatures []SignatureRequestResponseSignatures
+	for _, signature := range r.Signatures {
+		if signature.HasViewed() && ParseSignerStatus(signature.StatusCode) == SignerStatusAwaitingSignature {
+			signatures = append(signatures, signature)
+		}
+	}
+	return signatures
+}
+
+// NewSendSignatureRequestFromResponse rebuilds the request that produced a
+// template-based signature request, so it can be modified and sent again.
+//
+// The title, subject, message, metadata, signing redirect URL, test mode,
+// signers and custom field values are carried over. Settings the API does
+// not return are lost and must be set again on the result: signer PINs and
+// SMS phone numbers, CC recipients (whose roles are not returned), signing
+// options, AllowDecline and ClientID.
+//
+// Returns an error if the response was not created from a template or a
+// signer has no role.
+//
+// Example:
+//
+//	request, err := dropboxsign.NewSendSignatureRequestFromResponse(sigRequest)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	request.WithTitle("Corrected title")
+func NewSendSignatureRequestFromResponse(response *SignatureRequestResponse) (*SendSignatureRequest, error) {
+	if len(response.TemplateIDs) == 0 {
+		return nil, fmt.Errorf("signature request %s was not created from a template", response.SignatureRequestID)
+	}
+
+	signers := make([]SubSignatureRequestTemplateSigner, 0, len(response.Signatures))
+	for _, signature := range response.Signatures {
+		if signature.SignerRole == nil || *signature.SignerRole == "" {
+			return nil, fmt.Errorf("signature %s has no signer role", signature.SignatureID)
+		}
+		var name string
+		if signature.SignerName != nil {
+			name = *signature.SignerName
+		}
+		signers = append(signers, NewSubSignatureRequestTemplateSigner(*signature.SignerRole, name, signature.SignerEmailAddress))
+	}
+
+	request := NewSendSignatureRequest(signers, append([]string(nil), response.TemplateIDs...))
+	request.Subject = response.Subject
+	request.Message = response.Message
+	request.SigningRedirectURL = response.SigningRedirectURL
+	request.TestMode = response.TestMode
+	if response.Title != "" {
+		request.WithTitle(response.Title)
+	}
+
+	if len(response.Metadata) > 0 {
+		request.Metadata = make(map[string]string, len(response.Metadata))
+		for key, value := range response.Metadata {
+			request.Metadata[key] = value
+		}
+	}
+
+	for _, field := range response.CustomFields {
+		request.CustomFields = append(request.CustomFields, SubCustomField{
+			Name:     field.Name,
+			Editor:   field.Editor,
+			Required: field.Required,
+			Value:    field.Value,
+		})
+	}
+
+	return request, nil
+}
+
+// EmbeddedSignatureIDs returns the IDs of the signatures that can still be
+// signed through embedded signing.
+//
+// Embedded signature requests do not carry signing URLs. Signing is a two-step
+// flow: create the request, then call GetEmbeddedSignURL with each of these
+// IDs when the signer is ready, since sign URLs are short-lived. Only
+// signatures awaiting signature are returned; signatures with any other
+// status, such as signed, declined, on hold, expired or errored, are omitted.
+//
+// Example:
+//
+//	sigRequest, _, err := client.CreateEmbeddedWithTemplate(ctx, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, signatureID := range sigRequest.EmbeddedSignatureIDs() {
+//		embedded, _, err := client.GetEmbeddedSignURL(ctx, signatureID)
+//		// ...
+//	}
+func (r *SignatureRequestResponse) EmbeddedSignatureIDs() []string {
+	var ids []string
+	for _, signature := range r.Signatures {
+		if ParseSignerStatus(signature.StatusCode) == SignerStatusAwaitingSignature {
+			ids = append(ids, signature.SignatureID)
+		}
+	}
+	return ids
+}
+
+// ResponseDataBySigner groups the form field response data by the signature
+// it belongs to, keyed by signature ID.
+//
+// Entries without a signature ID, such as fields filled in by the requester,
+// are grouped under the empty string. Within each group, entries keep their
+// order from ResponseData.
+//
+// Example:
+//
+//	for signatureID, fields := range sigRequest.ResponseDataBySigner() {
+//		saveAnswers(signatureID, fields)
+//	}
+func (r *SignatureRequestResponse) ResponseDataBySigner() map[string][]SignatureRequestResponseData {
+	bySigner := make(map[string][]SignatureRequestResponseData)
+	for _, data := range r.ResponseData {
+		var signatureID string
+		if data.SignatureID != nil {
+			signatureID = *data.SignatureID
+		}
+		bySigner[signatureID] = append(bySigner[signatureID], data)
+	}
+	return bySigner
+}
+
+// FieldValues returns the values of ResponseData keyed by field name, so a
+// field can be read as values["employee_id"].
+//
+// Checkbox values are normalized to "true" or "false", dropdown and radio
+// values have surrounding whitespace trimmed, and a field without a value
+// maps to the empty string. Entries without a name are skipped. If several
+// entries share a name, the last one wins; use FieldValuesStrict to treat
+// that as an error.
+func (r *SignatureRequestResponse) FieldValues() map[string]string {
+	values, _ := r.fieldValues(false)
+	return values
+}
+
+// FieldValuesStrict is FieldValues, returning an error naming the field if
+// more than one entry of ResponseData has the same name.
+//
+// Example:
+//
+//	values, err := sigRequest.FieldValuesStrict()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	employeeID := values["employee_id"]
+func (r *SignatureRequestResponse) FieldValuesStrict() (map[string]string, error) {
+	return r.fieldValues(true)
+}
+
+// fieldValues implements FieldValues and FieldValuesStrict.
+func (r *SignatureRequestResponse) fieldValues(strict bool) (map[string]string, error) {
+	values := make(map[string]string, len(r.ResponseData))
+	for _, data := range r.ResponseData {
+		if data.Name == nil || *data.Name == "" {
+			continue
+		}
+		if _, ok := values[*data.Name]; ok && strict {
+			return nil, fmt.Errorf("response data has more than one field named %q", *data.Name)
+		}
+		values[*data.Name] = data.normalizedValue()
+	}
+	return values, nil
+}
+
+// normalizedValue returns the value of the field in the form used by
+// FieldValues.
+func (d SignatureRequestResponseData) normalizedValue() string {
+	var value string
+	if d.Value != nil {
+		value = *d.Value
+	}
+	if d.Type == nil {
+		return value
+	}
+
+	switch *d.Type {
+	case SignatureRequestResponseDataTypeCheckbox, SignatureRequestResponseDataTypeCheckboxMerge:
+		checked, err := strconv.ParseBool(strings.TrimSpace(value))
+		return strconv.FormatBool(err == nil && checked)
+	case SignatureRequestResponseDataTypeDropdown, SignatureRequestResponseDataTypeRadio:
+		return strings.TrimSpace(value)
+	default:
+		return value
+	}
+}
+
+// SignerGroup is a group of signers any one of whom may sign on behalf of
+// the group.
+//
+// The API identifies a group only by the GUID on each member's signature; it
+// does not return the group's name, so a group is built from the signatures
+// that share a GUID.
+type SignerGroup struct {
+	// GUID is the identifier of the signer group
+	GUID string
+	// Signatures are the signatures of the group's members, in response order
+	Signatures []SignatureRequestResponseSignatures
+}
+
+// SignedBy returns the signature of the member who signed for the group, or
+// nil if no member has signed yet.
+func (g *SignerGroup) SignedBy() *SignatureRequestResponseSignatures {
+	for i := range g.Signatures {
+		if ParseSignerStatus(g.Signatures[i].StatusCode) == SignerStatusSigned {
+			return &g.Signatures[i]
+		}
+	}
+	return nil
+}
+
+// SignerGroups returns the signer groups of the signature request, in the
+// order their first member appears in Signatures. Signatures that do not
+// belong to a group are left out.
+//
+// Example:
+//
+//	for _, group := range sigRequest.SignerGroups() {
+//		if signature := group.SignedBy(); signature != nil {
+//			fmt.Printf("group %s signed by %s\n", group.GUID, signature.SignerEmailAddress)
+//		}
+//	}
+func (r *SignatureRequestResponse) SignerGroups() []SignerGroup {
+	var groups []SignerGroup
+	index := make(map[string]int)
+	for _, signature := range r.Signatures {
+		if signature.SignerGroupGUID == nil || *signature.SignerGroupGUID == "" {
+			continue
+		}
+		guid := *signature.SignerGroupGUID
+		i, ok := index[guid]
+		if !ok {
+			i = len(groups)
+			index[guid] = i
+			groups = append(groups, SignerGroup{GUID: guid})
+		}
+		groups[i].Signatures = append(groups[i].Signatures, signature)
+	}
+	return groups
+}
+
+// SignatureRequestListResponse contains a page of signature requests.
+type SignatureRequestListResponse struct {
+	// SignatureRequests is the list of signature requests on this page
+	SignatureRequests []SignatureRequestResponse `json:"signature_requests"`
+	// ListInfo contains pagination information for the list
+	ListInfo ListInfo `json:"list_info"`
+}
+
+// ListSignatureRequestsOptions represents optional parameters for listing signature requests.
+//
+// Example:
+//
+//	opts := dropboxsign.NewListSignatureRequestsOptions().
+//		WithPage(2).
+//		WithPageSize(50)
+type ListSignatureRequestsOptions struct {
+	// AccountID restricts results to the given account, or "all" for every team member
+	AccountID *string
+	// Page is the page number to return (1-based)
+	Page *int
+	// PageSize is the number of objects to return per page (1-100)
+	PageSize *int
+	// Query is a search query used to filter signature requests
+	Query *string
+}
+
+// NewListSignatureRequestsOptions creates empty list options.
+func NewListSignatureRequestsOptions() *ListSignatureRequestsOptions {
+	return &ListSignatureRequestsOptions{}
+}
+
+// WithAccountID restricts results to the given account ID, or "all" for every team member.
+func (o *ListSignatureRequestsOptions) WithAccountID(accountID string) *ListSignatureRequestsOptions {
+	o.AccountID = &accountID
+	return o
+}
+
+// WithPage sets the page number to return.
+func (o *ListSignatureRequestsOptions) WithPage(page int) *ListSignatureRequestsOptions {
+	o.Page = &page
+	return o
+}
+
+// WithPageSize sets the number of objects to return per page.
+func (o *ListSignatureRequestsOptions) WithPageSize(pageSize int) *ListSignatureRequestsOptions {
+	o.PageSize = &pageSize
+	return o
+}
+
+// WithQuery sets the search query used to filter signature requests.
+//
+// Use QueryBuilder to build a query with correctly quoted values.
+func (o *ListSignatureRequestsOptions) WithQuery(query string) *ListSignatureRequestsOptions {
+	o.Query = &query
+	return o
+}
+
+// values encodes the options as URL query parameters.
+func (o *ListSignatureRequestsOptions) values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	if o.AccountID != nil {
+		values.Set("account_id", *o.AccountID)
+	}
+	if o.Page != nil {
+		values.Set("page", strconv.Itoa(*o.Page))
+	}
+	if o.PageSize != nil {
+		values.Set("page_size", strconv.Itoa(*o.PageSize))
+	}
+	if o.Query != nil {
+		values.Set("query", *o.Query)
+	}
+	return values
 }
 
 // SignatureRequestResponseCustomFieldBase represents base structure for custom form fields in signature request responses.
@@ -411,6 +1216,53 @@ type SignatureRequestResponseCustomFieldBase struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// UnmarshalJSON implements custom unmarshaling for SignatureRequestResponseCustomFieldBase.
+//
+// Checkbox fields carry a boolean value, which is stored in Value as "true" or "false".
+func (f *SignatureRequestResponseCustomFieldBase) UnmarshalJSON(data []byte) error {
+	type alias SignatureRequestResponseCustomFieldBase
+	aux := struct {
+		*alias
+		Value json.RawMessage `json:"value,omitempty"`
+	}{alias: (*alias)(f)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	value, err := decodeFieldValue(aux.Value)
+	if err != nil {
+		return fmt.Errorf("custom field %q value: %w", f.Name, err)
+	}
+	f.Value = value
+	return nil
+}
+
+// decodeFieldValue decodes a form field value, which the API sends as a
+// string for most field types but as a boolean or number for others, into its
+// string form. It returns nil for a missing or null value.
+func decodeFieldValue(raw json.RawMessage) (*string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		return &str, nil
+	}
+
+	var scalar any
+	if err := json.Unmarshal(raw, &scalar); err != nil {
+		return nil, err
+	}
+	switch scalar.(type) {
+	case bool, float64:
+		str = string(raw)
+		return &str, nil
+	default:
+		return nil, fmt.Errorf("unsupported value %s", raw)
+	}
+}
+
 // SignatureRequestResponseCustomFieldBaseType represents types of custom form fields available in signature requests.
 type SignatureRequestResponseCustomFieldBaseType string
 
@@ -425,6 +1277,10 @@ const (
 //
 // Represents additional documents that signers can upload as part of
 // the signing process.
+//
+// The Dropbox Sign API lists attachments on the signature request but does
+// not provide an endpoint for downloading an individual uploaded attachment,
+// so this package cannot fetch attachment contents.
 type SignatureRequestResponseAttachment struct {
 	// ID is the unique identifier for this attachment
 	ID string `json:"id"`
@@ -459,10 +1315,37 @@ type SignatureRequestResponseData struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// UnmarshalJSON implements custom unmarshaling for SignatureRequestResponseData.
+//
+// Checkbox and radio fields carry a boolean value, which is stored in Value
+// as "true" or "false".
+func (d *SignatureRequestResponseData) UnmarshalJSON(data []byte) error {
+	type alias SignatureRequestResponseData
+	aux := struct {
+		*alias
+		Value json.RawMessage `json:"value,omitempty"`
+	}{alias: (*alias)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	value, err := decodeFieldValue(aux.Value)
+	if err != nil {
+		return fmt.Errorf("response data value: %w", err)
+	}
+	d.Value = value
+	return nil
+}
+
 // SignatureRequestResponseSignatures represents individual signature status and metadata for each signer.
 //
 // Contains detailed information about each signer's interaction with
 // the signature request, including status, timestamps, and authentication details.
+//
+// The API does not return the IP address or device a signer signed from;
+// SignedAt is the only signing evidence in the response. The IP addresses and
+// timestamps of each event are recorded in the audit trail appended to the
+// signed files (see DownloadFilesTo).
 type SignatureRequestResponseSignatures struct {
 	// SignatureID is the unique identifier for this signature
 	SignatureID string `json:"signature_id"`
@@ -488,7 +1371,11 @@ type SignatureRequestResponseSignatures struct {
 	LastRemindedAt *int64 `json:"last_reminded_at,omitempty"`
 	// HasPin indicates whether this signer is required to enter a PIN
 	HasPin bool `json:"has_pin"`
-	// HasSMSAuth indicates whether SMS authentication is enabled for this signer
+	// HasSMSAuth indicates whether SMS authentication is enabled for this signer.
+	// The API does not report whether or when the signer completed the SMS
+	// check; since the document cannot be opened without it, a signer with SMS
+	// authentication whose status is signed has passed it. The signature
+	// request's audit trail records the verification itself.
 	HasSMSAuth *bool `json:"has_sms_auth,omitempty"`
 	// HasSMSDelivery indicates whether SMS delivery notifications are enabled for this signer
 	HasSMSDelivery *bool `json:"has_sms_delivery,omitempty"`
@@ -504,6 +1391,82 @@ type SignatureRequestResponseSignatures struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// String returns a concise summary of the signature with the signer's email
+// address and phone number masked, so signatures can be logged safely.
+func (s SignatureRequestResponseSignatures) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "signature_id=%s", s.SignatureID)
+	if s.SignerRole != nil {
+		fmt.Fprintf(&b, " role=%s", *s.SignerRole)
+	}
+	fmt.Fprintf(&b, " email=%s status=%s", maskEmail(s.SignerEmailAddress), s.StatusCode)
+	if s.SMSPhoneNumber != nil {
+		fmt.Fprintf(&b, " sms=%s", maskPhoneNumber(*s.SMSPhoneNumber))
+	}
+	return b.String()
+}
+
+// HasViewed reports whether the signer has viewed the signature request.
+func (s *SignatureRequestResponseSignatures) HasViewed() bool {
+	return s.LastViewedAt != nil
+}
+
+// SignedAtTime returns SignedAt as a time.Time, or nil if the signer has not signed.
+func (s *SignatureRequestResponseSignatures) SignedAtTime() *time.Time {
+	return unixTime(s.SignedAt)
+}
+
+// LastViewedAtTime returns LastViewedAt as a time.Time, or nil if the signer
+// has not viewed the signature request.
+func (s *SignatureRequestResponseSignatures) LastViewedAtTime() *time.Time {
+	return unixTime(s.LastViewedAt)
+}
+
+// LastRemindedAtTime returns LastRemindedAt as a time.Time, or nil if the
+// signer has not been sent a reminder.
+func (s *SignatureRequestResponseSignatures) LastRemindedAtTime() *time.Time {
+	return unixTime(s.LastRemindedAt)
+}
+
+// unixTime converts an optional Unix timestamp to a time.Time, returning nil
+// rather than the Unix epoch when the timestamp is unset.
+func unixTime(unix *int64) *time.Time {
+	if unix == nil {
+		return nil
+	}
+	t := time.Unix(*unix, 0)
+	return &t
+}
+
+// SignerAuthSummary summarizes the authentication methods enforced for a signer.
+type SignerAuthSummary struct {
+	// RequiresPIN indicates whether the signer must enter a PIN before signing
+	RequiresPIN bool
+	// RequiresSMSAuth indicates whether SMS authentication is enabled for the
+	// signer, not that the signer completed it (see HasSMSAuth)
+	RequiresSMSAuth bool
+	// SMSDelivery indicates whether the signature request is delivered to the signer by SMS
+	SMSDelivery bool
+	// MaskedPhoneNumber is the SMS phone number with all but the last two digits masked
+	MaskedPhoneNumber string
+}
+
+// AuthSummary returns the authentication methods enforced for this signer.
+//
+// Unset optional fields are reported as false, and the phone number is
+// masked so the summary is safe to include in reports and logs.
+func (s *SignatureRequestResponseSignatures) AuthSummary() SignerAuthSummary {
+	summary := SignerAuthSummary{
+		RequiresPIN:     s.HasPin,
+		RequiresSMSAuth: s.HasSMSAuth != nil && *s.HasSMSAuth,
+		SMSDelivery:     s.HasSMSDelivery != nil && *s.HasSMSDelivery,
+	}
+	if s.SMSPhoneNumber != nil {
+		summary.MaskedPhoneNumber = maskPhoneNumber(*s.SMSPhoneNumber)
+	}
+	return summary
+}
+
 // SignerStatus represents the status of a signer in a signature request.
 type SignerStatus string
 
@@ -0,0 +1,568 @@
+package dropboxsign
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignatureRequestResponseSignatures_AuthSummary(t *testing.T) {
+	hasSMSAuth := true
+	signature := SignatureRequestResponseSignatures{
+		HasPin:         true,
+		HasSMSAuth:     &hasSMSAuth,
+		SMSPhoneNumber: stringPtr("+14155550123"),
+	}
+
+	summary := signature.AuthSummary()
+
+	if !summary.RequiresPIN {
+		t.Error("expected RequiresPIN to be true")
+	}
+
+	if !summary.RequiresSMSAuth {
+		t.Error("expected RequiresSMSAuth to be true")
+	}
+
+	if summary.SMSDelivery {
+		t.Error("expected SMSDelivery to be false when unset")
+	}
+
+	if expected := "+*********23"; summary.MaskedPhoneNumber != expected {
+		t.Errorf("expected masked phone number %q, got %q", expected, summary.MaskedPhoneNumber)
+	}
+}
+
+func TestSubSignatureRequestTemplateSigner_String(t *testing.T) {
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "Jane Doe", "jane@example.com").
+		WithPin("1234").
+		WithSMSPhoneNumber("+14155550123")
+
+	expected := "role=Signer email=j***@example.com pin=set sms=+*********23"
+	if got := signer.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSignatureRequestResponseSignatures_String(t *testing.T) {
+	signature := SignatureRequestResponseSignatures{
+		SignatureID:        "sig-1",
+		SignerEmailAddress: "jane@example.com",
+		SignerRole:         stringPtr("Signer"),
+		StatusCode:         "signed",
+	}
+
+	expected := "signature_id=sig-1 role=Signer email=j***@example.com status=signed"
+	if got := signature.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSignatureRequestResponse_ViewedButNotSigned(t *testing.T) {
+	viewedAt := int64(1234567890)
+	sigRequest := SignatureRequestResponse{
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "never-opened", StatusCode: "awaiting_signature"},
+			{SignatureID: "opened", StatusCode: "awaiting_signature", LastViewedAt: &viewedAt},
+			{SignatureID: "signed", StatusCode: "signed", LastViewedAt: &viewedAt},
+			{SignatureID: "declined", StatusCode: "declined", LastViewedAt: &viewedAt},
+		},
+	}
+
+	signatures := sigRequest.ViewedButNotSigned()
+
+	if len(signatures) != 1 || signatures[0].SignatureID != "opened" {
+		t.Errorf("expected only the opened signature, got %+v", signatures)
+	}
+
+	if sigRequest.Signatures[0].HasViewed() {
+		t.Error("expected never-opened signature not to be viewed")
+	}
+}
+
+func TestSubSigningOptions_RoundTrip(t *testing.T) {
+	options := NewSubSigningOptions(SubSigningOptionsDefaultTypeType).
+		WithDraw(true).
+		WithPhone(false).
+		WithType(true).
+		WithUpload(true)
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		t.Fatalf("failed to marshal signing options: %v", err)
+	}
+
+	var decoded SubSigningOptions
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal signing options: %v", err)
+	}
+
+	if !reflect.DeepEqual(*options, decoded) {
+		t.Errorf("expected %+v, got %+v", *options, decoded)
+	}
+}
+
+func TestSubSigningOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *SubSigningOptions
+		wantErr bool
+	}{
+		{
+			name:    "valid default",
+			options: NewSubSigningOptions(SubSigningOptionsDefaultTypeDraw),
+		},
+		{
+			name:    "valid default explicitly enabled",
+			options: NewSubSigningOptions(SubSigningOptionsDefaultTypeUpload).WithUpload(true),
+		},
+		{
+			name:    "empty default",
+			options: &SubSigningOptions{},
+			wantErr: true,
+		},
+		{
+			name:    "unknown default",
+			options: NewSubSigningOptions("fax"),
+			wantErr: true,
+		},
+		{
+			name:    "default disabled",
+			options: NewSubSigningOptions(SubSigningOptionsDefaultTypePhone).WithPhone(false),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.options.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSendSignatureRequest_Marshal(t *testing.T) {
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com").
+		WithPin("1234")
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"}).
+		WithSubject("Please sign").
+		WithPopulateAutoFillFields(true)
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"subject":                   `"Please sign"`,
+		"populate_auto_fill_fields": `true`,
+		"template_ids":              `["template-id"]`,
+		"signers":                   `[{"role":"Signer","name":"John Doe","email_address":"john@example.com","pin":"1234"}]`,
+	}
+	for key, want := range expected {
+		if got := string(fields[key]); got != want {
+			t.Errorf("expected %s to be %s, got %s", key, want, got)
+		}
+	}
+	if _, ok := fields["title"]; ok {
+		t.Error("expected unset title to be omitted")
+	}
+}
+
+func TestNewSendSignatureRequestFromResponse(t *testing.T) {
+	response := &SignatureRequestResponse{
+		SignatureRequestID: "abc123",
+		Title:              "Contract",
+		Subject:            stringPtr("Please sign"),
+		Metadata:           map[string]string{"customer_id": "42"},
+		TemplateIDs:        []string{"template-id"},
+		CustomFields: []SignatureRequestResponseCustomFieldBase{
+			{Type: "text", Name: "company", Value: stringPtr("Acme")},
+		},
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerEmailAddress: "john@example.com", SignerName: stringPtr("John Doe"), SignerRole: stringPtr("Signer")},
+		},
+	}
+
+	request, err := NewSendSignatureRequestFromResponse(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if request.Title == nil || *request.Title != "Contract" {
+		t.Errorf("expected title Contract, got %v", request.Title)
+	}
+	if request.Subject == nil || *request.Subject != "Please sign" {
+		t.Errorf("expected subject to be preserved, got %v", request.Subject)
+	}
+	if request.Metadata["customer_id"] != "42" {
+		t.Errorf("expected metadata to be preserved, got %v", request.Metadata)
+	}
+	if len(request.CustomFields) != 1 || *request.CustomFields[0].Value != "Acme" {
+		t.Errorf("expected custom fields to be preserved, got %v", request.CustomFields)
+	}
+	expected := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	if len(request.Signers) != 1 || !reflect.DeepEqual(request.Signers[0], expected) {
+		t.Errorf("expected signer %v, got %v", expected, request.Signers)
+	}
+
+	request.Metadata["customer_id"] = "43"
+	if response.Metadata["customer_id"] != "42" {
+		t.Error("expected response metadata to be copied")
+	}
+
+	response.TemplateIDs = nil
+	if _, err := NewSendSignatureRequestFromResponse(response); err == nil {
+		t.Error("expected error for response without templates, got nil")
+	}
+}
+
+func TestSignatureRequestResponse_EmbeddedSignatureIDs(t *testing.T) {
+	response := &SignatureRequestResponse{
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", StatusCode: "awaiting_signature"},
+			{SignatureID: "sig-2", StatusCode: "signed"},
+			{SignatureID: "sig-3", StatusCode: "awaiting_signature"},
+		},
+	}
+
+	expected := []string{"sig-1", "sig-3"}
+	if ids := response.EmbeddedSignatureIDs(); !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestSignatureRequestResponse_EmbeddedSignatureIDs_EveryStatus(t *testing.T) {
+	statuses := []SignerStatus{
+		SignerStatusSuccess,
+		SignerStatusOnHold,
+		SignerStatusSigned,
+		SignerStatusAwaitingSignature,
+		SignerStatusDeclined,
+		SignerStatusErrorUnknown,
+		SignerStatusErrorFile,
+		SignerStatusErrorComponentPosition,
+		SignerStatusErrorTextTag,
+		SignerStatusOnHoldByRequester,
+		SignerStatusErrorInvalidEmail,
+		SignerStatusExpired,
+		SignerStatusUnknownEnum,
+	}
+
+	for _, status := range statuses {
+		t.Run(string(status), func(t *testing.T) {
+			response := &SignatureRequestResponse{
+				Signatures: []SignatureRequestResponseSignatures{{SignatureID: "sig-1", StatusCode: string(status)}},
+			}
+
+			ids := response.EmbeddedSignatureIDs()
+			if status == SignerStatusAwaitingSignature {
+				if !reflect.DeepEqual(ids, []string{"sig-1"}) {
+					t.Errorf("expected [sig-1], got %v", ids)
+				}
+			} else if len(ids) != 0 {
+				t.Errorf("expected no IDs, got %v", ids)
+			}
+		})
+	}
+}
+
+func TestSignatureRequestResponse_ResponseDataBySigner(t *testing.T) {
+	response := &SignatureRequestResponse{
+		ResponseData: []SignatureRequestResponseData{
+			{APIID: stringPtr("a1"), SignatureID: stringPtr("sig-1")},
+			{APIID: stringPtr("a2"), SignatureID: stringPtr("sig-2")},
+			{APIID: stringPtr("a3"), SignatureID: stringPtr("sig-1")},
+			{APIID: stringPtr("a4")},
+		},
+	}
+
+	bySigner := response.ResponseDataBySigner()
+
+	expected := map[string][]string{
+		"sig-1": {"a1", "a3"},
+		"sig-2": {"a2"},
+		"":      {"a4"},
+	}
+	if len(bySigner) != len(expected) {
+		t.Fatalf("expected %d groups, got %d", len(expected), len(bySigner))
+	}
+	for signatureID, apiIDs := range expected {
+		group := bySigner[signatureID]
+		if len(group) != len(apiIDs) {
+			t.Errorf("expected %d entries for %q, got %d", len(apiIDs), signatureID, len(group))
+			continue
+		}
+		for i, apiID := range apiIDs {
+			if *group[i].APIID != apiID {
+				t.Errorf("expected %q entry %d to be %s, got %s", signatureID, i, apiID, *group[i].APIID)
+			}
+		}
+	}
+}
+
+func TestSignatureRequestResponse_SignerGroups(t *testing.T) {
+	response := &SignatureRequestResponse{
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignatureID: "sig-1", SignerGroupGUID: stringPtr("group-a"), StatusCode: "awaiting_signature"},
+			{SignatureID: "sig-2", StatusCode: "awaiting_signature"},
+			{SignatureID: "sig-3", SignerGroupGUID: stringPtr("group-b"), StatusCode: "awaiting_signature"},
+			{SignatureID: "sig-4", SignerGroupGUID: stringPtr("group-a"), StatusCode: "signed"},
+		},
+	}
+
+	groups := response.SignerGroups()
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(groups))
+	}
+
+	if groups[0].GUID != "group-a" || len(groups[0].Signatures) != 2 {
+		t.Errorf("expected group-a with 2 members, got %s with %d", groups[0].GUID, len(groups[0].Signatures))
+	}
+	if signedBy := groups[0].SignedBy(); signedBy == nil || signedBy.SignatureID != "sig-4" {
+		t.Errorf("expected group-a to be signed by sig-4, got %v", signedBy)
+	}
+
+	if groups[1].GUID != "group-b" || len(groups[1].Signatures) != 1 {
+		t.Errorf("expected group-b with 1 member, got %s with %d", groups[1].GUID, len(groups[1].Signatures))
+	}
+	if signedBy := groups[1].SignedBy(); signedBy != nil {
+		t.Errorf("expected group-b to be unsigned, got %s", signedBy.SignatureID)
+	}
+}
+
+func TestSubCC_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(NewSubCC("Legal", "legal@example.com"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := `{"role":"Legal","email_address":"legal@example.com"}`; string(data) != want {
+		t.Errorf("expected %s, got %s", want, data)
+	}
+}
+
+func TestSubAttachment_MarshalJSON(t *testing.T) {
+	attachment := NewSubAttachment("Photo ID", 0).
+		WithInstructions("Upload a photo of your passport").
+		WithRequired(true)
+
+	data, err := json.Marshal(attachment)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `{"name":"Photo ID","signer_index":0,"instructions":"Upload a photo of your passport","required":true}`
+	if string(data) != want {
+		t.Errorf("expected %s, got %s", want, data)
+	}
+}
+
+func TestSendSignatureRequest_Clone(t *testing.T) {
+	base := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+		NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com").WithPin("1234").WithOrder(0),
+	}, []string{"template-id"}).
+		WithAllowDecline(true).
+		WithAttachments([]SubAttachment{NewSubAttachment("Photo ID", 0).WithRequired(true)}).
+		WithCCs([]SubCC{NewSubCC("Legal", "legal@example.com")}).
+		WithClientID("client-id").
+		WithCustomFields([]SubCustomField{NewSubCustomField("company").WithValue("Acme")}).
+		WithFiles([][]byte{[]byte("%PDF-1.4")}).
+		WithFileNames("contract.pdf").
+		WithFileURLs([]string{"https://example.com/contract.pdf"}).
+		WithIsEID(false).
+		WithMessage("Please sign").
+		WithMetadata(map[string]string{"tenant": "acme"}).
+		WithSigningOptions(NewSubSigningOptions(SubSigningOptionsDefaultTypeDraw).WithDraw(true)).
+		WithSigningRedirectURL("https://example.com/done").
+		WithSubject("Contract").
+		WithPopulateAutoFillFields(true).
+		WithTestMode(true).
+		WithTitle("Contract")
+
+	clone := base.Clone()
+	if !reflect.DeepEqual(base, clone) {
+		t.Fatalf("expected clone to equal the original, got %+v", clone)
+	}
+
+	// Every non-nil pointer, slice and map of the clone must be a copy, so
+	// that fields added to SendSignatureRequest later are not missed.
+	original, copied := reflect.ValueOf(base).Elem(), reflect.ValueOf(clone).Elem()
+	for i := 0; i < original.NumField(); i++ {
+		field := original.Type().Field(i)
+		switch field.Type.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map:
+			if original.Field(i).IsNil() {
+				t.Errorf("test request does not set %s", field.Name)
+				continue
+			}
+			if original.Field(i).Pointer() == copied.Field(i).Pointer() {
+				t.Errorf("expected %s to be copied", field.Name)
+			}
+		}
+	}
+
+	clone.Metadata["tenant"] = "globex"
+	clone.Signers[0].EmailAddress = "jane@example.com"
+	*clone.Signers[0].Pin = "9999"
+	*clone.Attachments[0].Required = false
+	*clone.CustomFields[0].Value = "Globex"
+	*clone.SigningOptions.Draw = false
+	*clone.Title = "Changed"
+
+	if base.Metadata["tenant"] != "acme" || base.Signers[0].EmailAddress != "john@example.com" || *base.Signers[0].Pin != "1234" ||
+		!*base.Attachments[0].Required || *base.CustomFields[0].Value != "Acme" || !*base.SigningOptions.Draw || *base.Title != "Contract" {
+		t.Errorf("expected changes to the clone not to affect the original, got %+v", base)
+	}
+}
+
+func TestSignatureRequestResponse_Clone(t *testing.T) {
+	base := &SignatureRequestResponse{}
+	fillFields(reflect.ValueOf(base).Elem())
+
+	clone := base.Clone()
+	if !reflect.DeepEqual(base, clone) {
+		t.Fatalf("expected clone to equal the original, got %+v", clone)
+	}
+	// Every pointer, slice and map of the clone, including those of the
+	// nested signatures, fields and attachments, must be a copy.
+	assertNoSharedFields(t, "SignatureRequestResponse", reflect.ValueOf(base).Elem(), reflect.ValueOf(clone).Elem())
+
+	clone.Metadata["key"] = "changed"
+	*clone.Signatures[0].SignerName = "changed"
+	if reflect.DeepEqual(base, clone) {
+		t.Error("expected changes to the clone not to affect the original")
+	}
+}
+
+// fillFields sets every pointer, slice and map reachable from v to a non-nil
+// value holding one element.
+func fillFields(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fillFields(v.Field(i))
+			}
+		}
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillFields(v.Elem())
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillFields(v.Index(0))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(reflect.New(v.Type().Key()).Elem(), reflect.New(v.Type().Elem()).Elem())
+	case reflect.String:
+		v.SetString("value")
+	}
+}
+
+// assertNoSharedFields reports every pointer, slice or map reachable from
+// original that is shared with copied.
+func assertNoSharedFields(t *testing.T, path string, original, copied reflect.Value) {
+	t.Helper()
+	switch original.Kind() {
+	case reflect.Struct:
+		for i := 0; i < original.NumField(); i++ {
+			if original.Type().Field(i).IsExported() {
+				assertNoSharedFields(t, path+"."+original.Type().Field(i).Name, original.Field(i), copied.Field(i))
+			}
+		}
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		if original.IsNil() {
+			return
+		}
+		if original.Pointer() == copied.Pointer() {
+			t.Errorf("expected %s to be copied", path)
+			return
+		}
+		if original.Kind() == reflect.Pointer {
+			assertNoSharedFields(t, path, original.Elem(), copied.Elem())
+		} else if original.Kind() == reflect.Slice {
+			for i := 0; i < original.Len(); i++ {
+				assertNoSharedFields(t, fmt.Sprintf("%s[%d]", path, i), original.Index(i), copied.Index(i))
+			}
+		}
+	}
+}
+
+func TestSignatureRequestResponse_FieldValues(t *testing.T) {
+	dataType := func(t SignatureRequestResponseDataType) *SignatureRequestResponseDataType { return &t }
+	response := &SignatureRequestResponse{
+		ResponseData: []SignatureRequestResponseData{
+			{Name: stringPtr("employee_id"), Type: dataType(SignatureRequestResponseDataTypeText), Value: stringPtr("E-42")},
+			{Name: stringPtr("agree"), Type: dataType(SignatureRequestResponseDataTypeCheckbox), Value: stringPtr("1")},
+			{Name: stringPtr("newsletter"), Type: dataType(SignatureRequestResponseDataTypeCheckbox)},
+			{Name: stringPtr("department"), Type: dataType(SignatureRequestResponseDataTypeDropdown), Value: stringPtr(" Sales ")},
+			{Name: stringPtr("notes"), Type: dataType(SignatureRequestResponseDataTypeText)},
+			{APIID: stringPtr("unnamed"), Value: stringPtr("ignored")},
+		},
+	}
+
+	expected := map[string]string{
+		"employee_id": "E-42",
+		"agree":       "true",
+		"newsletter":  "false",
+		"department":  "Sales",
+		"notes":       "",
+	}
+	if values := response.FieldValues(); !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+	if _, err := response.FieldValuesStrict(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	response.ResponseData = append(response.ResponseData, SignatureRequestResponseData{Name: stringPtr("employee_id"), Value: stringPtr("E-43")})
+	if values := response.FieldValues(); values["employee_id"] != "E-43" {
+		t.Errorf("expected the last employee_id to win, got %q", values["employee_id"])
+	}
+	if _, err := response.FieldValuesStrict(); err == nil || !strings.Contains(err.Error(), `"employee_id"`) {
+		t.Errorf("expected duplicate field error, got %v", err)
+	}
+}
+
+func TestTimeAccessors(t *testing.T) {
+	created, expires, signed := int64(1700000000), int64(1700086400), int64(1700003600)
+	response := &SignatureRequestResponse{
+		CreatedAt: created,
+		ExpiresAt: &expires,
+		Signatures: []SignatureRequestResponseSignatures{
+			{SignedAt: &signed},
+		},
+	}
+
+	if got := response.CreatedAtTime(); !got.Equal(time.Unix(created, 0)) {
+		t.Errorf("expected created at %v, got %v", time.Unix(created, 0), got)
+	}
+	if got := response.ExpiresAtTime(); got == nil || !got.Equal(time.Unix(expires, 0)) {
+		t.Errorf("expected expires at %v, got %v", time.Unix(expires, 0), got)
+	}
+
+	signature := &response.Signatures[0]
+	if got := signature.SignedAtTime(); got == nil || !got.Equal(time.Unix(signed, 0)) {
+		t.Errorf("expected signed at %v, got %v", time.Unix(signed, 0), got)
+	}
+	if got := signature.LastViewedAtTime(); got != nil {
+		t.Errorf("expected nil last viewed at, got %v", got)
+	}
+	if got := signature.LastRemindedAtTime(); got != nil {
+		t.Errorf("expected nil last reminded at, got %v", got)
+	}
+
+	response.ExpiresAt = nil
+	if got := response.ExpiresAtTime(); got != nil {
+		t.Errorf("expected nil expires at, got %v", got)
+	}
+}
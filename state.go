@@ -0,0 +1,83 @@
+package dropboxsign
+
+import (
+	"strings"
+	"time"
+)
+
+// RequestState is the overall state of a signature request, derived from its
+// flags and the statuses of its signers.
+type RequestState string
+
+const (
+	// RequestStateDraft means the signature request has not been created yet,
+	// such as one synthesized in dry-run mode
+	RequestStateDraft RequestState = "draft"
+	// RequestStateSent means the signature request is awaiting its first signature
+	RequestStateSent RequestState = "sent"
+	// RequestStatePartiallySigned means some, but not all, signers have signed
+	RequestStatePartiallySigned RequestState = "partially_signed"
+	// RequestStateCompleted means every signer has signed
+	RequestStateCompleted RequestState = "completed"
+	// RequestStateDeclined means a signer declined to sign
+	RequestStateDeclined RequestState = "declined"
+	// RequestStateCanceled means the requester canceled the signature request.
+	// The API has no flag for canceled requests, so State never reports it;
+	// it is provided for callers that record cancellations themselves.
+	RequestStateCanceled RequestState = "canceled"
+	// RequestStateErrored means the signature request could not be processed
+	RequestStateErrored RequestState = "errored"
+	// RequestStateExpired means the signature request expired before it was completed
+	RequestStateExpired RequestState = "expired"
+)
+
+// State returns the overall state of the signature request.
+//
+// When several states apply, the first of the following wins: draft (no
+// SignatureRequestID), errored (HasError or a signer with an error status),
+// declined, completed, expired (a signer with the expired status, or
+// ExpiresAt in the past), partially signed, and otherwise sent.
+//
+// Example:
+//
+//	switch sigRequest.State() {
+//	case dropboxsign.RequestStateCompleted:
+//		archive(sigRequest)
+//	case dropboxsign.RequestStateDeclined, dropboxsign.RequestStateExpired:
+//		notifyOwner(sigRequest)
+//	}
+func (r *SignatureRequestResponse) State() RequestState {
+	if r.SignatureRequestID == "" {
+		return RequestStateDraft
+	}
+
+	var errored, declined, expired, signed bool
+	for _, signature := range r.Signatures {
+		status := ParseSignerStatus(signature.StatusCode)
+		switch {
+		case strings.HasPrefix(string(status), "error_"):
+			errored = true
+		case status == SignerStatusDeclined:
+			declined = true
+		case status == SignerStatusExpired:
+			expired = true
+		case status == SignerStatusSigned:
+			signed = true
+		}
+	}
+
+	switch {
+	case r.HasError || errored:
+		return RequestStateErrored
+	case r.IsDeclined || declined:
+		return RequestStateDeclined
+	case r.IsComplete:
+		return RequestStateCompleted
+	case expired || (r.ExpiresAt != nil && r.ExpiresAtTime().Before(time.Now())):
+		return RequestStateExpired
+	case signed:
+		return RequestStatePartiallySigned
+	default:
+		return RequestStateSent
+	}
+}
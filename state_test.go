@@ -0,0 +1,89 @@
+package dropboxsign
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignatureRequestResponse_State(t *testing.T) {
+	past := time.Now().Add(-time.Hour).Unix()
+	future := time.Now().Add(time.Hour).Unix()
+
+	signatures := func(statuses ...string) []SignatureRequestResponseSignatures {
+		var result []SignatureRequestResponseSignatures
+		for _, status := range statuses {
+			result = append(result, SignatureRequestResponseSignatures{StatusCode: status})
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		response SignatureRequestResponse
+		expected RequestState
+	}{
+		{
+			name:     "draft",
+			response: SignatureRequestResponse{Signatures: signatures("awaiting_signature")},
+			expected: RequestStateDraft,
+		},
+		{
+			name:     "sent",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", ExpiresAt: &future, Signatures: signatures("awaiting_signature", "awaiting_signature")},
+			expected: RequestStateSent,
+		},
+		{
+			name:     "partially signed",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", Signatures: signatures("signed", "awaiting_signature")},
+			expected: RequestStatePartiallySigned,
+		},
+		{
+			name:     "completed",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", IsComplete: true, Signatures: signatures("signed", "signed")},
+			expected: RequestStateCompleted,
+		},
+		{
+			name:     "completed before expiry passed",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", IsComplete: true, ExpiresAt: &past, Signatures: signatures("signed")},
+			expected: RequestStateCompleted,
+		},
+		{
+			name:     "declined by signer status",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", Signatures: signatures("signed", "declined")},
+			expected: RequestStateDeclined,
+		},
+		{
+			name:     "declined flag",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", IsDeclined: true, Signatures: signatures("awaiting_signature")},
+			expected: RequestStateDeclined,
+		},
+		{
+			name:     "errored wins over declined",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", IsDeclined: true, Signatures: signatures("declined", "error_invalid_email")},
+			expected: RequestStateErrored,
+		},
+		{
+			name:     "has error flag",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", HasError: true},
+			expected: RequestStateErrored,
+		},
+		{
+			name:     "expired by signer status",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", Signatures: signatures("signed", "expired")},
+			expected: RequestStateExpired,
+		},
+		{
+			name:     "expired by date",
+			response: SignatureRequestResponse{SignatureRequestID: "abc123", ExpiresAt: &past, Signatures: signatures("signed", "awaiting_signature")},
+			expected: RequestStateExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.response.State(); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
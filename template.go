@@ -0,0 +1,237 @@
+package dropboxsign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// TemplateResponse contains the details of a template.
+type TemplateResponse struct {
+	// TemplateID is the unique identifier of the template
+	TemplateID string `json:"template_id"`
+	// Title is the title of the template
+	Title *string `json:"title,omitempty"`
+	// Message is the default message sent to signers. Templates have no
+	// default subject; the subject of a request defaults to its title.
+	Message *string `json:"message,omitempty"`
+	// Metadata contains the custom metadata of the template
+	Metadata map[string]any `json:"metadata,omitempty"`
+	// SignerRoles are the signer roles defined by the template
+	SignerRoles []TemplateResponseSignerRole `json:"signer_roles,omitempty"`
+	// CCRoles are the CC roles defined by the template
+	CCRoles []TemplateResponseCCRole `json:"cc_roles,omitempty"`
+	// CustomFields are the merge fields defined by the template
+	CustomFields []TemplateResponseCustomField `json:"custom_fields,omitempty"`
+	// NamedFormFields are the form fields signers fill in, described in the
+	// same shape as CustomFields
+	NamedFormFields []TemplateResponseCustomField `json:"named_form_fields,omitempty"`
+	// IsCreator indicates whether the requesting account created the template
+	IsCreator *bool `json:"is_creator,omitempty"`
+	// IsEmbedded indicates whether the template was created for embedded use
+	IsEmbedded *bool `json:"is_embedded,omitempty"`
+	// CanEdit indicates whether the requesting account can edit the template
+	CanEdit *bool `json:"can_edit,omitempty"`
+	// IsLocked indicates whether the template is locked from editing
+	IsLocked *bool `json:"is_locked,omitempty"`
+	// Documents are the documents of the template, in signing order
+	Documents []TemplateResponseDocument `json:"documents,omitempty"`
+	// UpdatedAt is the Unix timestamp when the template was last modified
+	UpdatedAt *int64 `json:"updated_at,omitempty"`
+}
+
+// TemplateResponseDocument is a document of a template.
+type TemplateResponseDocument struct {
+	// Name is the file name of the document
+	Name string `json:"name"`
+	// Index is the zero-based position of the document in the template
+	Index *int `json:"index,omitempty"`
+	// FormFields are the form fields placed on the document
+	FormFields []TemplateResponseCustomField `json:"form_fields,omitempty"`
+	// CustomFields are the merge fields placed on the document
+	CustomFields []TemplateResponseCustomField `json:"custom_fields,omitempty"`
+}
+
+// TemplateResponseSignerRole is a signer role defined by a template.
+type TemplateResponseSignerRole struct {
+	// Name is the name of the role
+	Name string `json:"name"`
+	// Order is the signing order of the role, if the template has one
+	Order *int `json:"order,omitempty"`
+}
+
+// TemplateResponseCCRole is a CC role defined by a template.
+type TemplateResponseCCRole struct {
+	// Name is the name of the role
+	Name string `json:"name"`
+}
+
+// TemplateResponseCustomField is a field defined by a template: a merge field
+// in CustomFields, or a form field in NamedFormFields.
+type TemplateResponseCustomField struct {
+	// Name is the name of the field
+	Name string `json:"name"`
+	// Type is the type of the field, such as "text" or "checkbox"
+	Type string `json:"type"`
+	// APIID is the unique identifier of the field
+	APIID *string `json:"api_id,omitempty"`
+	// Required specifies whether the field must be filled in
+	Required *bool `json:"required,omitempty"`
+	// Signer is the signer role, or its 1-based index, that fills in the
+	// field; merge fields filled in by the sender have none
+	Signer *string `json:"signer,omitempty"`
+	// Group is the name of the group the field belongs to, if any
+	Group *string `json:"group,omitempty"`
+	// X is the horizontal position of the field on the page, in pixels
+	X *int `json:"x,omitempty"`
+	// Y is the vertical position of the field on the page, in pixels
+	Y *int `json:"y,omitempty"`
+	// Width is the width of the field, in pixels
+	Width *int `json:"width,omitempty"`
+	// Height is the height of the field, in pixels
+	Height *int `json:"height,omitempty"`
+}
+
+// UnmarshalJSON implements custom unmarshaling for TemplateResponseCustomField.
+//
+// The API sends Signer as a string or as a number, which is stored in its
+// string form.
+func (f *TemplateResponseCustomField) UnmarshalJSON(data []byte) error {
+	type alias TemplateResponseCustomField
+	aux := struct {
+		*alias
+		Signer json.RawMessage `json:"signer,omitempty"`
+	}{alias: (*alias)(f)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	signer, err := decodeFieldValue(aux.Signer)
+	if err != nil {
+		return fmt.Errorf("template field %q signer: %w", f.Name, err)
+	}
+	f.Signer = signer
+	return nil
+}
+
+// TemplateListResponse is a page of templates returned by ListTemplates.
+type TemplateListResponse struct {
+	// Templates is the list of templates on this page
+	Templates []TemplateResponse `json:"templates"`
+	// ListInfo contains pagination information for the list
+	ListInfo ListInfo `json:"list_info"`
+}
+
+// ListTemplateOptions represents optional parameters for listing templates.
+type ListTemplateOptions struct {
+	// AccountID restricts results to the given account, or "all" for every team member
+	AccountID *string
+	// Page is the page number to return (1-based)
+	Page *int
+	// PageSize is the number of objects to return per page (1-100)
+	PageSize *int
+	// Query is a search query used to filter templates
+	Query *string
+}
+
+// NewListTemplateOptions creates empty list options.
+func NewListTemplateOptions() *ListTemplateOptions {
+	return &ListTemplateOptions{}
+}
+
+// WithAccountID restricts results to the given account ID, or "all" for every team member.
+func (o *ListTemplateOptions) WithAccountID(accountID string) *ListTemplateOptions {
+	o.AccountID = &accountID
+	return o
+}
+
+// WithPage sets the page number to return.
+func (o *ListTemplateOptions) WithPage(page int) *ListTemplateOptions {
+	o.Page = &page
+	return o
+}
+
+// WithPageSize sets the number of objects to return per page.
+func (o *ListTemplateOptions) WithPageSize(pageSize int) *ListTemplateOptions {
+	o.PageSize = &pageSize
+	return o
+}
+
+// WithQuery sets the search query used to filter templates, such as a
+// title.
+func (o *ListTemplateOptions) WithQuery(query string) *ListTemplateOptions {
+	o.Query = &query
+	return o
+}
+
+// values encodes the options as URL query parameters.
+func (o *ListTemplateOptions) values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	if o.AccountID != nil {
+		values.Set("account_id", *o.AccountID)
+	}
+	if o.Page != nil {
+		values.Set("page", strconv.Itoa(*o.Page))
+	}
+	if o.PageSize != nil {
+		values.Set("page_size", strconv.Itoa(*o.PageSize))
+	}
+	if o.Query != nil {
+		values.Set("query", *o.Query)
+	}
+	return values
+}
+
+// ListTemplates retrieves a page of the templates available to the account.
+//
+// The account ID set with WithAccountID (on the client or ctx) is used
+// unless opts sets one. Pass nil to use the API defaults. Listed templates
+// are not added to the template cache.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	opts := dropboxsign.NewListTemplateOptions().WithPageSize(50)
+//	list, _, err := client.ListTemplates(ctx, opts)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, template := range list.Templates {
+//		fmt.Println(template.TemplateID)
+//	}
+func (c *Client) ListTemplates(ctx context.Context, opts *ListTemplateOptions) (*TemplateListResponse, []WarningResponse, error) {
+	values := opts.values()
+	if !values.Has("account_id") {
+		if accountID := c.accountIDFor(ctx); accountID != "" {
+			values.Set("account_id", accountID)
+		}
+	}
+
+	path := "/template/list"
+	if query := values.Encode(); query != "" {
+		path += "?" + query
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, statusCode, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list, warnings, err := parseListResponse[TemplateListResponse](c.marshaler, body)
+	if err != nil {
+		return nil, nil, NewClientError("failed to parse response", statusCode, err)
+	}
+
+	return list, warnings, nil
+}
@@ -0,0 +1,194 @@
+package dropboxsign
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// templateCache holds templates fetched by GetTemplate for a fixed duration.
+type templateCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]templateCacheEntry
+	now     func() time.Time
+}
+
+// templateCacheEntry is a cached template and when it was fetched.
+type templateCacheEntry struct {
+	template  TemplateResponse
+	fetchedAt time.Time
+}
+
+// WithTemplateCache caches templates returned by GetTemplate for ttl.
+//
+// Templates rarely change, so services that validate sends against the same
+// templates can avoid fetching them on every request. Use WarmTemplateCache
+// to fetch a known set of templates up front. A ttl of zero disables the cache.
+//
+// Returns the client instance for method chaining.
+func (c *Client) WithTemplateCache(ttl time.Duration) *Client {
+	if ttl <= 0 {
+		c.templateCache = nil
+		return c
+	}
+	c.templateCache = &templateCache{
+		ttl:     ttl,
+		entries: make(map[string]templateCacheEntry),
+		now:     time.Now,
+	}
+	return c
+}
+
+// WarmTemplateCache fetches the given templates concurrently so later calls
+// to GetTemplate are served from the template cache.
+//
+// The returned map contains an entry for each template that could not be
+// fetched, keyed by template ID; it is empty when every template was fetched.
+// If the API responds with a rate limit error, no further templates are
+// fetched and the remaining IDs are reported with that error. Without
+// WithTemplateCache the templates are fetched but not kept, which still
+// checks that they exist.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient(apiKey).WithTemplateCache(time.Hour)
+//	for templateID, err := range client.WarmTemplateCache(ctx, templateIDs) {
+//		log.Printf("template %s: %v", templateID, err)
+//	}
+func (c *Client) WarmTemplateCache(ctx context.Context, templateIDs []string) map[string]error {
+	errs := runBatch(ctx, len(templateIDs), defaultBatchConcurrency, func(ctx context.Context, i int) error {
+		_, _, err := c.GetTemplate(ctx, templateIDs[i])
+		return err
+	})
+
+	failed := make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			failed[templateIDs[i]] = err
+		}
+	}
+	return failed
+}
+
+// get returns a deep copy of the cached template, if present and not
+// expired, so callers may modify it without affecting the cache.
+func (tc *templateCache) get(templateID string) (*TemplateResponse, bool) {
+	if tc == nil {
+		return nil, false
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	entry, ok := tc.entries[templateID]
+	if !ok {
+		return nil, false
+	}
+	if tc.now().Sub(entry.fetchedAt) >= tc.ttl {
+		delete(tc.entries, templateID)
+		return nil, false
+	}
+	template := cloneTemplate(&entry.template)
+	return &template, true
+}
+
+// put stores a deep copy of template, so later changes by the caller do not
+// affect the cache.
+func (tc *templateCache) put(template *TemplateResponse) {
+	if tc == nil {
+		return
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.entries[template.TemplateID] = templateCacheEntry{template: cloneTemplate(template), fetchedAt: tc.now()}
+}
+
+// clear removes every cached template.
+func (tc *templateCache) clear() {
+	if tc == nil {
+		return
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	clear(tc.entries)
+}
+
+// cloneTemplate returns a deep copy of template.
+func cloneTemplate(template *TemplateResponse) TemplateResponse {
+	clone := *template
+	clone.Title = clonePtr(template.Title)
+	clone.Message = clonePtr(template.Message)
+	clone.IsCreator = clonePtr(template.IsCreator)
+	clone.IsEmbedded = clonePtr(template.IsEmbedded)
+	clone.CanEdit = clonePtr(template.CanEdit)
+	clone.IsLocked = clonePtr(template.IsLocked)
+	clone.UpdatedAt = clonePtr(template.UpdatedAt)
+	clone.CCRoles = cloneSlice(template.CCRoles)
+	clone.CustomFields = cloneTemplateFields(template.CustomFields)
+	clone.NamedFormFields = cloneTemplateFields(template.NamedFormFields)
+
+	if template.Metadata != nil {
+		clone.Metadata = cloneJSONValue(template.Metadata).(map[string]any)
+	}
+	if template.SignerRoles != nil {
+		clone.SignerRoles = make([]TemplateResponseSignerRole, len(template.SignerRoles))
+		for i, role := range template.SignerRoles {
+			role.Order = clonePtr(role.Order)
+			clone.SignerRoles[i] = role
+		}
+	}
+	if template.Documents != nil {
+		clone.Documents = make([]TemplateResponseDocument, len(template.Documents))
+		for i, document := range template.Documents {
+			document.Index = clonePtr(document.Index)
+			document.FormFields = cloneTemplateFields(document.FormFields)
+			document.CustomFields = cloneTemplateFields(document.CustomFields)
+			clone.Documents[i] = document
+		}
+	}
+	return clone
+}
+
+// cloneTemplateFields returns a deep copy of fields, preserving nil.
+func cloneTemplateFields(fields []TemplateResponseCustomField) []TemplateResponseCustomField {
+	if fields == nil {
+		return nil
+	}
+	clone := make([]TemplateResponseCustomField, len(fields))
+	for i, field := range fields {
+		field.APIID = clonePtr(field.APIID)
+		field.Required = clonePtr(field.Required)
+		field.Signer = clonePtr(field.Signer)
+		field.Group = clonePtr(field.Group)
+		field.X = clonePtr(field.X)
+		field.Y = clonePtr(field.Y)
+		field.Width = clonePtr(field.Width)
+		field.Height = clonePtr(field.Height)
+		clone[i] = field
+	}
+	return clone
+}
+
+// cloneJSONValue returns a deep copy of a value decoded from JSON, copying
+// nested objects and arrays.
+func cloneJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		clone := make(map[string]any, len(v))
+		for key, item := range v {
+			clone[key] = cloneJSONValue(item)
+		}
+		return clone
+	case []any:
+		clone := make([]any, len(v))
+		for i, item := range v {
+			clone[i] = cloneJSONValue(item)
+		}
+		return clone
+	default:
+		return v
+	}
+}
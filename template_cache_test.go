@@ -0,0 +1,144 @@
+package dropboxsign
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTemplateServer(t *testing.T, calls *atomic.Int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		templateID := strings.TrimPrefix(r.URL.Path, "/v3/template/")
+		if templateID == "missing" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			if _, err := w.Write([]byte(`{"error": {"error_msg": "Template not found", "error_name": "not_found"}}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"template": {"template_id": "` + templateID + `", "title": "NDA", "metadata": {"tags": ["legal"]}, "signer_roles": [{"name": "Client", "order": 0}]}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+}
+
+func TestGetTemplate_Cache(t *testing.T) {
+	var calls atomic.Int64
+	server := newTemplateServer(t, &calls)
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithTemplateCache(time.Hour)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.templateCache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	template, _, err := client.GetTemplate(ctx, "tmpl-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(template.SignerRoles) != 1 || template.SignerRoles[0].Name != "Client" {
+		t.Errorf("unexpected signer roles: %+v", template.SignerRoles)
+	}
+
+	template.TemplateID = "modified"
+	template.SignerRoles[0].Name = "modified"
+	*template.SignerRoles[0].Order = 5
+	template.Metadata["tags"].([]any)[0] = "modified"
+	cached, _, err := client.GetTemplate(ctx, "tmpl-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cached.TemplateID != "tmpl-1" {
+		t.Errorf("expected cached template to be unaffected by caller changes, got %s", cached.TemplateID)
+	}
+	if role := cached.SignerRoles[0]; role.Name != "Client" || *role.Order != 0 {
+		t.Errorf("expected cached signer roles to be unaffected by caller changes, got %+v", role)
+	}
+	if tag := cached.Metadata["tags"].([]any)[0]; tag != "legal" {
+		t.Errorf("expected cached metadata to be unaffected by caller changes, got %v", tag)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 API call, got %d", calls.Load())
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, _, err := client.GetTemplate(ctx, "tmpl-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected expired template to be fetched again, got %d calls", calls.Load())
+	}
+}
+
+func TestWarmTemplateCache(t *testing.T) {
+	var calls atomic.Int64
+	server := newTemplateServer(t, &calls)
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithTemplateCache(time.Hour)
+
+	ctx := context.Background()
+	failed := client.WarmTemplateCache(ctx, []string{"tmpl-1", "missing", "tmpl-2"})
+	if len(failed) != 1 || !IsNotFound(failed["missing"]) {
+		t.Fatalf("expected only the missing template to fail, got %v", failed)
+	}
+
+	for _, templateID := range []string{"tmpl-1", "tmpl-2"} {
+		if _, _, err := client.GetTemplate(ctx, templateID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected warmed templates to be served from the cache, got %d calls", calls.Load())
+	}
+}
+
+// idleClosingTransport records calls to CloseIdleConnections.
+type idleClosingTransport struct {
+	http.RoundTripper
+	closed bool
+}
+
+func (t *idleClosingTransport) CloseIdleConnections() {
+	t.closed = true
+}
+
+func TestClose(t *testing.T) {
+	var calls atomic.Int64
+	server := newTemplateServer(t, &calls)
+	defer server.Close()
+
+	transport := &idleClosingTransport{RoundTripper: http.DefaultTransport}
+	client := NewClient("test-api-key").
+		WithBaseURL(server.URL + "/v3").
+		WithHTTPClient(&http.Client{Transport: transport}).
+		WithTemplateCache(time.Hour)
+
+	ctx := context.Background()
+	if _, _, err := client.GetTemplate(ctx, "tmpl-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !transport.closed {
+		t.Error("expected idle connections to be closed")
+	}
+
+	if _, _, err := client.GetTemplate(ctx, "tmpl-1"); err != nil {
+		t.Fatalf("unexpected error after close: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected template cache to be emptied, got %d calls", calls.Load())
+	}
+}
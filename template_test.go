@@ -0,0 +1,162 @@
+package dropboxsign
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestTemplateResponse_Fixture(t *testing.T) {
+	data, err := os.ReadFile("testdata/template_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	template, warnings, err := parseResponse[TemplateResponse](data, "template")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("expected no warnings, got %v", warnings)
+	}
+
+	if template.Title == nil || *template.Title != "Mutual NDA" {
+		t.Errorf("expected title Mutual NDA, got %v", template.Title)
+	}
+	if template.Message == nil || *template.Message != "Please sign this NDA before our first meeting." {
+		t.Errorf("expected message, got %v", template.Message)
+	}
+	if template.Metadata["department"] != "legal" {
+		t.Errorf("expected metadata department legal, got %v", template.Metadata)
+	}
+	if template.IsCreator == nil || !*template.IsCreator || template.IsLocked == nil || *template.IsLocked {
+		t.Errorf("expected creator and unlocked flags, got %v and %v", template.IsCreator, template.IsLocked)
+	}
+
+	if len(template.SignerRoles) != 2 {
+		t.Fatalf("expected 2 signer roles, got %d", len(template.SignerRoles))
+	}
+	for i, name := range []string{"Disclosing Party", "Receiving Party"} {
+		role := template.SignerRoles[i]
+		if role.Name != name || role.Order == nil || *role.Order != i {
+			t.Errorf("expected signer role %d to be %s with order %d, got %+v", i, name, i, role)
+		}
+	}
+
+	if len(template.CCRoles) != 2 || template.CCRoles[1].Name != "Account Manager" {
+		t.Errorf("expected CC roles Legal and Account Manager, got %+v", template.CCRoles)
+	}
+
+	if len(template.CustomFields) != 3 {
+		t.Fatalf("expected 3 custom fields, got %d", len(template.CustomFields))
+	}
+	company := template.CustomFields[0]
+	if company.Name != "company_name" || company.Type != "text" || company.Required == nil || !*company.Required {
+		t.Errorf("expected required text field company_name, got %+v", company)
+	}
+	if company.Width == nil || *company.Width != 300 {
+		t.Errorf("expected width 300, got %v", company.Width)
+	}
+	if exhibit := template.CustomFields[2]; exhibit.Type != "checkbox" || exhibit.Group == nil || *exhibit.Group != "exhibits" {
+		t.Errorf("expected checkbox in group exhibits, got %+v", exhibit)
+	}
+
+	if len(template.NamedFormFields) != 2 {
+		t.Fatalf("expected 2 named form fields, got %d", len(template.NamedFormFields))
+	}
+	if signature := template.NamedFormFields[1]; signature.Type != "signature" || signature.Signer == nil || *signature.Signer != "2" {
+		t.Errorf("expected signature field for signer 2, got %+v", signature)
+	}
+
+	if len(template.Documents) != 1 {
+		t.Fatalf("expected 1 document, got %d", len(template.Documents))
+	}
+	document := template.Documents[0]
+	if document.Name != "nda.pdf" || document.Index == nil || *document.Index != 0 {
+		t.Errorf("expected document nda.pdf at index 0, got %+v", document)
+	}
+	if len(document.FormFields) != 1 || len(document.CustomFields) != 1 || document.CustomFields[0].Name != "company_name" {
+		t.Errorf("expected the document's fields, got %+v", document)
+	}
+}
+
+func TestListTemplates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/template/list" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("page_size") != "50" || query.Get("query") != "NDA" || query.Get("account_id") != "account-1" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"templates": [{"template_id": "tpl-1", "title": "Mutual NDA", "signer_roles": [{"name": "Client"}]}, {"template_id": "tpl-2"}], "list_info": {"num_pages": 1, "num_results": 2, "page": 1, "page_size": 50}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithAccountID("account-1")
+
+	list, _, err := client.ListTemplates(context.Background(), NewListTemplateOptions().WithPageSize(50).WithQuery("NDA"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Templates) != 2 || list.Templates[0].TemplateID != "tpl-1" || list.Templates[0].SignerRoles[0].Name != "Client" {
+		t.Errorf("unexpected templates: %+v", list.Templates)
+	}
+	if list.ListInfo.NumResults == nil || *list.ListInfo.NumResults != 2 {
+		t.Errorf("expected 2 results, got %v", list.ListInfo.NumResults)
+	}
+}
+
+func TestSendSignatureRequest_ValidateRoles(t *testing.T) {
+	template := &TemplateResponse{
+		SignerRoles: []TemplateResponseSignerRole{{Name: "Client"}, {Name: "Witness"}},
+		CCRoles:     []TemplateResponseCCRole{{Name: "Legal"}},
+	}
+
+	tests := []struct {
+		name    string
+		signers []SubSignatureRequestTemplateSigner
+		ccs     []SubCC
+		wantErr string
+	}{
+		{
+			name: "matching roles",
+			signers: []SubSignatureRequestTemplateSigner{
+				NewSubSignatureRequestTemplateSigner("client", "Jane Doe", "jane@example.com"),
+				NewSubSignatureRequestTemplateSigner(" Witness ", "John Doe", "john@example.com"),
+			},
+			ccs: []SubCC{NewSubCC("Legal", "legal@example.com")},
+		},
+		{
+			name: "unknown and missing roles",
+			signers: []SubSignatureRequestTemplateSigner{
+				NewSubSignatureRequestTemplateSigner("Client", "Jane Doe", "jane@example.com"),
+				NewSubSignatureRequestTemplateSigner("Witnes", "John Doe", "john@example.com"),
+			},
+			ccs:     []SubCC{NewSubCC("Finance", "finance@example.com")},
+			wantErr: "signers[1] role \"Witnes\" is not a signer role of the template\nccs[0] role \"Finance\" is not a CC role of the template\nsigner role \"Witness\" of the template has no signer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := NewSendSignatureRequest(tt.signers, []string{"template-id"}).WithCCs(tt.ccs)
+			err := request.ValidateRoles(template)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("expected %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,423 @@
+package dropboxsign
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// mergeFieldPattern matches a {field_name} placeholder in a subject or message.
+var mergeFieldPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// e164Pattern matches a phone number in E.164 format, such as "+14155550123".
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// Validate checks the request for problems that the API would reject, or that
+// would leave a signer stuck, without making a network call.
+//
+// Among other checks, the request must have at least one signer, each with a
+// name, a bare email address, a role when the request uses templates, a PIN
+// of 4 to 12 digits if set and an SMS phone number in E.164 format if set.
+// All problems found are returned together as a single joined error. See
+// WithValidation to validate every request before it is sent.
+//
+// Example:
+//
+//	if err := request.Validate(); err != nil {
+//		log.Fatalf("invalid signature request: %v", err)
+//	}
+func (s *SendSignatureRequest) Validate() error {
+	var errs []error
+
+	if s.SigningOptions != nil {
+		if err := s.SigningOptions.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(s.Files) > 0 && len(s.FileURLs) > 0 {
+		errs = append(errs, errors.New("files and file_urls cannot both be set"))
+	}
+	if len(s.TemplateIDs) == 0 && len(s.Files) == 0 && len(s.FileURLs) == 0 {
+		errs = append(errs, errors.New("template_ids, files or file_urls is required"))
+	}
+	errs = append(errs, validateTemplateIDs(s.TemplateIDs)...)
+	errs = append(errs, validateFileURLs(s.FileURLs)...)
+	errs = append(errs, validateSigners(s)...)
+	errs = append(errs, validateRecipientEmails(s)...)
+	errs = append(errs, validateSignerPins(s.Signers)...)
+	errs = append(errs, validateSignerOrder(s.Signers)...)
+	errs = append(errs, validateAttachments(s)...)
+	errs = append(errs, validateCustomFieldDependencies(s.CustomFields)...)
+	errs = append(errs, validateCustomFieldEditors(s)...)
+
+	return errors.Join(errs...)
+}
+
+// WithValidation makes the methods that send a signature request, such as
+// SendWithTemplate, Send and CreateEmbeddedWithTemplate, check the request
+// with Validate first and fail with a *ClientError listing every problem
+// instead of making a network call. SendWithTemplate and
+// CreateEmbeddedWithTemplate also require template IDs.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient("api-key").WithValidation(true)
+func (c *Client) WithValidation(enabled bool) *Client {
+	c.validation = enabled
+	return c
+}
+
+// validateForSend checks request with Validate when validation is enabled
+// (see WithValidation). withTemplate additionally requires template IDs.
+func (c *Client) validateForSend(request *SendSignatureRequest, withTemplate bool) error {
+	if !c.validation {
+		return nil
+	}
+
+	err := request.Validate()
+	if withTemplate && len(request.TemplateIDs) == 0 {
+		err = errors.Join(errors.New("template_ids is required"), err)
+	}
+	if err != nil {
+		return NewClientError("invalid signature request", 0, err)
+	}
+	return nil
+}
+
+// ValidateMergeFields checks that every {field_name} placeholder in the
+// request's Subject and Message names a custom field of one of templates,
+// so that a typo such as {frist_name} is caught before a signer sees it.
+//
+// Names are compared case-insensitively, ignoring surrounding whitespace.
+// All unknown placeholders are returned together as a single joined error.
+//
+// Example:
+//
+//	template, _, err := client.GetTemplate(ctx, "template_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	request.WithSubject("{first_name}, your contract is ready")
+//	if err := request.ValidateMergeFields(template); err != nil {
+//		log.Fatalf("invalid merge fields: %v", err)
+//	}
+func (s *SendSignatureRequest) ValidateMergeFields(templates ...*TemplateResponse) error {
+	known := make(map[string]bool)
+	for _, template := range templates {
+		for _, field := range template.CustomFields {
+			known[strings.ToLower(strings.TrimSpace(field.Name))] = true
+		}
+	}
+
+	var errs []error
+	for _, text := range []struct {
+		name  string
+		value *string
+	}{
+		{"subject", s.Subject},
+		{"message", s.Message},
+	} {
+		if text.value == nil {
+			continue
+		}
+		for _, match := range mergeFieldPattern.FindAllStringSubmatch(*text.value, -1) {
+			if !known[strings.ToLower(strings.TrimSpace(match[1]))] {
+				errs = append(errs, fmt.Errorf("%s placeholder %s is not a custom field of the template", text.name, match[0]))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateRoles checks that the request's signer and CC roles match the roles
+// defined by templates: every signer and CC must have a role of one of the
+// templates, and every signer role of the templates must be filled.
+//
+// Roles are compared case-insensitively, ignoring surrounding whitespace.
+// All problems are returned together as a single joined error.
+//
+// Example:
+//
+//	template, _, err := client.GetTemplate(ctx, "template_id")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := request.ValidateRoles(template); err != nil {
+//		log.Fatalf("signers do not match the template: %v", err)
+//	}
+func (s *SendSignatureRequest) ValidateRoles(templates ...*TemplateResponse) error {
+	signerRoles := make(map[string]bool)
+	ccRoles := make(map[string]bool)
+	for _, template := range templates {
+		for _, role := range template.SignerRoles {
+			signerRoles[normalizeRole(role.Name)] = true
+		}
+		for _, role := range template.CCRoles {
+			ccRoles[normalizeRole(role.Name)] = true
+		}
+	}
+
+	var errs []error
+	filled := make(map[string]bool, len(s.Signers))
+	for i, signer := range s.Signers {
+		role := normalizeRole(signer.Role)
+		if !signerRoles[role] {
+			errs = append(errs, fmt.Errorf("signers[%d] role %q is not a signer role of the template", i, signer.Role))
+			continue
+		}
+		filled[role] = true
+	}
+	for i, cc := range s.CCs {
+		if !ccRoles[normalizeRole(cc.Role)] {
+			errs = append(errs, fmt.Errorf("ccs[%d] role %q is not a CC role of the template", i, cc.Role))
+		}
+	}
+	for _, template := range templates {
+		for _, role := range template.SignerRoles {
+			if key := normalizeRole(role.Name); !filled[key] {
+				filled[key] = true
+				errs = append(errs, fmt.Errorf("signer role %q of the template has no signer", role.Name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// normalizeRole returns a role name in the form used to compare roles.
+func normalizeRole(role string) string {
+	return strings.ToLower(strings.TrimSpace(role))
+}
+
+// normalized returns a copy of the request with surrounding whitespace
+// trimmed from its template IDs and signer and CC email addresses, which are
+// easily picked up when the values are copied and pasted. The request itself
+// is not modified.
+//
+// It returns an error if a template ID is empty once trimmed.
+func (s *SendSignatureRequest) normalized() (*SendSignatureRequest, error) {
+	if errs := validateTemplateIDs(s.TemplateIDs); len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	normalized := *s
+	normalized.TemplateIDs = append([]string(nil), s.TemplateIDs...)
+	for i := range normalized.TemplateIDs {
+		normalized.TemplateIDs[i] = strings.TrimSpace(normalized.TemplateIDs[i])
+	}
+	normalized.Signers = append([]SubSignatureRequestTemplateSigner(nil), s.Signers...)
+	for i := range normalized.Signers {
+		normalized.Signers[i].EmailAddress = strings.TrimSpace(normalized.Signers[i].EmailAddress)
+	}
+	normalized.CCs = append([]SubCC(nil), s.CCs...)
+	for i := range normalized.CCs {
+		normalized.CCs[i].Email = strings.TrimSpace(normalized.CCs[i].Email)
+	}
+	return &normalized, nil
+}
+
+// validateTemplateIDs checks that no template ID is empty or only whitespace.
+func validateTemplateIDs(templateIDs []string) []error {
+	var errs []error
+	for i, templateID := range templateIDs {
+		if strings.TrimSpace(templateID) == "" {
+			errs = append(errs, fmt.Errorf("template_ids[%d] is empty", i))
+		}
+	}
+	return errs
+}
+
+// validateFileURLs checks that every file URL is an absolute HTTPS URL.
+func validateFileURLs(fileURLs []string) []error {
+	var errs []error
+	for i, fileURL := range fileURLs {
+		u, err := url.Parse(fileURL)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("file_urls[%d] is not a valid URL: %w", i, err))
+			continue
+		}
+		if u.Scheme != "https" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("file_urls[%d] must be an https URL, got %q", i, fileURL))
+		}
+	}
+	return errs
+}
+
+// validateSigners checks that the request has at least one signer, that
+// every signer has a name (and a role when the request uses templates), and
+// that SMS phone numbers are in E.164 format.
+func validateSigners(s *SendSignatureRequest) []error {
+	if len(s.Signers) == 0 {
+		return []error{errors.New("at least one signer is required")}
+	}
+
+	var errs []error
+	for i, signer := range s.Signers {
+		if len(s.TemplateIDs) > 0 && strings.TrimSpace(signer.Role) == "" {
+			errs = append(errs, fmt.Errorf("signers[%d] role is empty", i))
+		}
+		if strings.TrimSpace(signer.Name) == "" {
+			errs = append(errs, fmt.Errorf("signers[%d] name is empty", i))
+		}
+		if signer.SMSPhoneNumber != nil && !e164Pattern.MatchString(*signer.SMSPhoneNumber) {
+			errs = append(errs, fmt.Errorf("signers[%d] sms_phone_number must be in E.164 format, such as +14155550123", i))
+		}
+	}
+	return errs
+}
+
+// validateRecipientEmails checks that every signer and CC email is a bare
+// email address. Surrounding whitespace is ignored, since it is trimmed
+// before the request is sent.
+func validateRecipientEmails(s *SendSignatureRequest) []error {
+	var errs []error
+	for i, signer := range s.Signers {
+		if err := validateEmailAddress(strings.TrimSpace(signer.EmailAddress)); err != nil {
+			errs = append(errs, fmt.Errorf("signers[%d] email_address: %w", i, err))
+		}
+	}
+	for i, cc := range s.CCs {
+		if err := validateEmailAddress(strings.TrimSpace(cc.Email)); err != nil {
+			errs = append(errs, fmt.Errorf("ccs[%d] email_address: %w", i, err))
+		}
+	}
+	return errs
+}
+
+// validateEmailAddress checks that email is a single RFC 5322 address with no
+// display name, which is the only form the API accepts in email fields.
+func validateEmailAddress(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid email address", email)
+	}
+	if addr.Address != email {
+		return fmt.Errorf("%q must be a bare email address, such as %q", email, addr.Address)
+	}
+	return nil
+}
+
+// validateSignerPins checks that every signer PIN is 4 to 12 digits.
+//
+// The PIN itself is never included in the error, since it is a secret.
+func validateSignerPins(signers []SubSignatureRequestTemplateSigner) []error {
+	var errs []error
+	for i, signer := range signers {
+		if signer.Pin == nil {
+			continue
+		}
+		pin := *signer.Pin
+		if index := strings.IndexFunc(pin, func(r rune) bool { return r < '0' || r > '9' }); index >= 0 {
+			errs = append(errs, fmt.Errorf("signers[%d] pin must be 4–12 digits, got a non-digit at position %d", i, index+1))
+			continue
+		}
+		if len(pin) < 4 || len(pin) > 12 {
+			errs = append(errs, fmt.Errorf("signers[%d] pin must be 4–12 digits, got %d", i, len(pin)))
+		}
+	}
+	return errs
+}
+
+// validateSignerOrder checks that no two signers share the same order.
+func validateSignerOrder(signers []SubSignatureRequestTemplateSigner) []error {
+	var errs []error
+	seen := make(map[int]int, len(signers))
+	for i, signer := range signers {
+		if signer.Order == nil {
+			continue
+		}
+		if first, ok := seen[*signer.Order]; ok {
+			errs = append(errs, fmt.Errorf("signers[%d] has the same order %d as signers[%d]", i, *signer.Order, first))
+			continue
+		}
+		seen[*signer.Order] = i
+	}
+	return errs
+}
+
+// validateAttachments checks that every attachment has a name and refers to
+// one of the request's signers.
+func validateAttachments(s *SendSignatureRequest) []error {
+	var errs []error
+	for i, attachment := range s.Attachments {
+		if strings.TrimSpace(attachment.Name) == "" {
+			errs = append(errs, fmt.Errorf("attachments[%d] name is empty", i))
+		}
+		if attachment.SignerIndex < 0 || attachment.SignerIndex >= len(s.Signers) {
+			errs = append(errs, fmt.Errorf("attachments[%d] signer_index %d is out of range for %d signers", i, attachment.SignerIndex, len(s.Signers)))
+		}
+	}
+	return errs
+}
+
+// validateCustomFieldDependencies checks that every field with a RequiredIf
+// dependency refers to a known field and has a value whenever that field is checked.
+func validateCustomFieldDependencies(fields []SubCustomField) []error {
+	byName := make(map[string]SubCustomField, len(fields))
+	for _, field := range fields {
+		byName[field.Name] = field
+	}
+
+	var errs []error
+	for _, field := range fields {
+		if field.RequiredIf == nil {
+			continue
+		}
+
+		dependency, ok := byName[*field.RequiredIf]
+		if !ok {
+			errs = append(errs, fmt.Errorf("custom field %q is required if unknown field %q is checked", field.Name, *field.RequiredIf))
+			continue
+		}
+
+		if isChecked(dependency.Value) && (field.Value == nil || strings.TrimSpace(*field.Value) == "") {
+			errs = append(errs, fmt.Errorf("custom field %q is required because %q is checked", field.Name, dependency.Name))
+		}
+	}
+	return errs
+}
+
+// validateCustomFieldEditors checks that every custom field editor is one of
+// the request's signers or CC recipients, compared case-insensitively.
+func validateCustomFieldEditors(s *SendSignatureRequest) []error {
+	recipients := make(map[string]bool, len(s.Signers)+len(s.CCs))
+	for _, signer := range s.Signers {
+		recipients[strings.ToLower(strings.TrimSpace(signer.EmailAddress))] = true
+	}
+	for _, cc := range s.CCs {
+		recipients[strings.ToLower(strings.TrimSpace(cc.Email))] = true
+	}
+
+	var errs []error
+	for _, field := range s.CustomFields {
+		if field.Editor == nil {
+			continue
+		}
+		if err := validateEmailAddress(*field.Editor); err != nil {
+			errs = append(errs, fmt.Errorf("custom field %q editor: %w", field.Name, err))
+			continue
+		}
+		if !recipients[strings.ToLower(strings.TrimSpace(*field.Editor))] {
+			errs = append(errs, fmt.Errorf("custom field %q editor %q is not a signer or CC of the request", field.Name, *field.Editor))
+		}
+	}
+	return errs
+}
+
+// isChecked reports whether a checkbox field value represents a checked box.
+func isChecked(value *string) bool {
+	if value == nil {
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(*value)) {
+	case "true", "1", "yes", "on", "x", "checked":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,405 @@
+package dropboxsign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newValidRequest() *SendSignatureRequest {
+	signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com")
+	return NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"})
+}
+
+func TestSendSignatureRequest_Validate_RequiredIf(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []SubCustomField
+		wantErr string
+	}{
+		{
+			name: "dependency unchecked",
+			fields: []SubCustomField{
+				NewSubCustomField("married").WithValue("false"),
+				NewSubCustomField("spouse_name").WithRequiredIf("married"),
+			},
+		},
+		{
+			name: "dependency checked and value set",
+			fields: []SubCustomField{
+				NewSubCustomField("married").WithValue("true"),
+				NewSubCustomField("spouse_name").WithRequiredIf("married").WithValue("Jane Doe"),
+			},
+		},
+		{
+			name: "dependency checked and value missing",
+			fields: []SubCustomField{
+				NewSubCustomField("married").WithValue("true"),
+				NewSubCustomField("spouse_name").WithRequiredIf("married"),
+			},
+			wantErr: `custom field "spouse_name" is required because "married" is checked`,
+		},
+		{
+			name: "unknown dependency",
+			fields: []SubCustomField{
+				NewSubCustomField("spouse_name").WithRequiredIf("maried"),
+			},
+			wantErr: `unknown field "maried"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newValidRequest().WithCustomFields(tt.fields).Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSubCustomField_RequiredIfNotSent(t *testing.T) {
+	field := NewSubCustomField("spouse_name").WithRequiredIf("married")
+
+	data, err := json.Marshal(field)
+	if err != nil {
+		t.Fatalf("failed to marshal custom field: %v", err)
+	}
+
+	if strings.Contains(string(data), "married") {
+		t.Errorf("expected RequiredIf not to be sent, got %s", data)
+	}
+}
+
+func TestSendSignatureRequest_WithSequentialSigning(t *testing.T) {
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+		NewSubSignatureRequestTemplateSigner("Employee", "John Doe", "john@example.com"),
+		NewSubSignatureRequestTemplateSigner("Manager", "Jane Doe", "jane@example.com").WithOrder(0),
+	}, []string{"template-id"})
+
+	if err := request.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	request.WithSequentialSigning()
+
+	for i, signer := range request.Signers {
+		if signer.Order == nil || *signer.Order != i {
+			t.Errorf("expected signers[%d] order %d, got %v", i, i, signer.Order)
+		}
+	}
+
+	if err := request.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSendSignatureRequest_Validate_DuplicateOrder(t *testing.T) {
+	request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+		NewSubSignatureRequestTemplateSigner("Employee", "John Doe", "john@example.com").WithOrder(1),
+		NewSubSignatureRequestTemplateSigner("Manager", "Jane Doe", "jane@example.com").WithOrder(1),
+	}, []string{"template-id"})
+
+	err := request.Validate()
+	if err == nil || !strings.Contains(err.Error(), "signers[1] has the same order 1 as signers[0]") {
+		t.Errorf("expected duplicate order error, got %v", err)
+	}
+}
+
+func TestSendSignatureRequest_Validate_FileURLs(t *testing.T) {
+	request := newValidRequest().WithFileURLs([]string{
+		"https://example.com/contract.pdf",
+		"http://example.com/contract.pdf",
+		"/contract.pdf",
+	})
+
+	err := request.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	for _, want := range []string{"file_urls[1] must be an https URL", "file_urls[2] must be an https URL"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q, got %v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "file_urls[0]") {
+		t.Errorf("expected file_urls[0] to be valid, got %v", err)
+	}
+}
+
+func TestSendSignatureRequest_Validate_CustomFieldEditors(t *testing.T) {
+	request := newValidRequest().
+		WithCCs([]SubCC{NewSubCC("Legal", "legal@example.com")}).
+		WithCustomFields([]SubCustomField{
+			NewSubCustomField("signer_field").WithEditor("John@Example.com"),
+			NewSubCustomField("cc_field").WithEditor("legal@example.com"),
+			NewSubCustomField("typo_field").WithEditor("jonh@example.com"),
+		})
+
+	err := request.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if want := `custom field "typo_field" editor "jonh@example.com" is not a signer or CC of the request`; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestSendSignatureRequest_Validate_Pin(t *testing.T) {
+	tests := []struct {
+		name    string
+		pin     string
+		wantErr string
+	}{
+		{name: "valid", pin: "1234"},
+		{name: "max length", pin: "123456789012"},
+		{name: "too short", pin: "123", wantErr: "signers[0] pin must be 4–12 digits, got 3"},
+		{name: "too long", pin: "1234567890123", wantErr: "signers[0] pin must be 4–12 digits, got 13"},
+		{name: "contains space", pin: "12 34", wantErr: "signers[0] pin must be 4–12 digits, got a non-digit at position 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com").WithPin(tt.pin)
+			request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"})
+
+			err := request.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("expected %q, got %v", tt.wantErr, err)
+			}
+			if err != nil && strings.Contains(err.Error(), tt.pin) {
+				t.Errorf("expected error not to contain the pin, got %q", err.Error())
+			}
+		})
+	}
+}
+
+func TestSendSignatureRequest_Validate_FilesAndFileURLs(t *testing.T) {
+	request := newValidRequest().
+		WithFiles([][]byte{[]byte("%PDF-1.4")}).
+		WithFileURLs([]string{"https://example.com/contract.pdf"})
+
+	err := request.Validate()
+	if err == nil || err.Error() != "files and file_urls cannot both be set" {
+		t.Errorf("expected files and file_urls error, got %v", err)
+	}
+}
+
+func TestSendSignatureRequest_Validate_EmailAddresses(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		wantErr string
+	}{
+		{name: "valid", email: "john@example.com"},
+		{name: "plus addressing", email: "john+contracts@example.com"},
+		{name: "display name", email: "John Doe <john@example.com>", wantErr: `signers[0] email_address: "John Doe <john@example.com>" must be a bare email address, such as "john@example.com"`},
+		{name: "angle brackets", email: "<john@example.com>", wantErr: `signers[0] email_address: "<john@example.com>" must be a bare email address, such as "john@example.com"`},
+		{name: "missing domain", email: "john", wantErr: `signers[0] email_address: "john" is not a valid email address`},
+		{name: "empty", email: "", wantErr: `signers[0] email_address: "" is not a valid email address`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := NewSubSignatureRequestTemplateSigner("Signer", "John Doe", tt.email)
+			request := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{signer}, []string{"template-id"})
+
+			err := request.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("expected %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSendSignatureRequest_Validate_Signers(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *SendSignatureRequest
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			request: newValidRequest(),
+		},
+		{
+			name:    "no signers",
+			request: NewSendSignatureRequest(nil, []string{"template-id"}),
+			wantErr: "at least one signer is required",
+		},
+		{
+			name: "missing role and name",
+			request: NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+				NewSubSignatureRequestTemplateSigner(" ", "", "john@example.com"),
+			}, []string{"template-id"}),
+			wantErr: "signers[0] role is empty\nsigners[0] name is empty",
+		},
+		{
+			name: "role not needed without templates",
+			request: NewSendSignatureRequestWithFiles([]SubSignatureRequestTemplateSigner{
+				NewSubSignatureRequestTemplateSigner("", "John Doe", "john@example.com"),
+			}, [][]byte{[]byte("%PDF-1.4")}),
+		},
+		{
+			name: "valid sms phone number",
+			request: NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+				NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com").WithSMSPhoneNumber("+14155550123"),
+			}, []string{"template-id"}),
+		},
+		{
+			name: "sms phone number not in e164 format",
+			request: NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+				NewSubSignatureRequestTemplateSigner("Signer", "John Doe", "john@example.com").WithSMSPhoneNumber("(415) 555-0123"),
+			}, []string{"template-id"}),
+			wantErr: "signers[0] sms_phone_number must be in E.164 format, such as +14155550123",
+		},
+		{
+			name: "no documents",
+			request: NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+				NewSubSignatureRequestTemplateSigner("", "John Doe", "john@example.com"),
+			}, nil),
+			wantErr: "template_ids, files or file_urls is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("expected %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWithValidation(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	invalid := NewSendSignatureRequest([]SubSignatureRequestTemplateSigner{
+		NewSubSignatureRequestTemplateSigner("Signer", "", "not-an-email"),
+	}, nil).WithFileURLs([]string{"https://example.com/contract.pdf"})
+
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3")
+	if _, _, err := client.SendWithTemplate(context.Background(), invalid); err != nil {
+		t.Fatalf("expected the request to be sent without validation, got %v", err)
+	}
+
+	client.WithValidation(true)
+	_, _, err := client.SendWithTemplate(context.Background(), invalid)
+	var clientErr *ClientError
+	if !errors.As(err, &clientErr) {
+		t.Fatalf("expected ClientError, got %v", err)
+	}
+	for _, want := range []string{"template_ids is required", "signers[0] name is empty", `"not-an-email" is not a valid email address`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got %v", want, err)
+		}
+	}
+
+	if _, _, err := client.SendWithTemplate(context.Background(), newValidRequest()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests to be sent, got %d", requests)
+	}
+}
+
+func TestSendSignatureRequest_Validate_CCAndEditorEmails(t *testing.T) {
+	request := newValidRequest().
+		WithCCs([]SubCC{NewSubCC("Legal", "Legal <legal@example.com>")}).
+		WithCustomFields([]SubCustomField{NewSubCustomField("field").WithEditor("john@")})
+
+	err := request.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	for _, want := range []string{
+		`ccs[0] email_address: "Legal <legal@example.com>" must be a bare email address`,
+		`custom field "field" editor: "john@" is not a valid email address`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got %q", want, err.Error())
+		}
+	}
+}
+
+func TestSendSignatureRequest_Validate_Attachments(t *testing.T) {
+	request := newValidRequest().WithAttachments([]SubAttachment{
+		NewSubAttachment("Photo ID", 0).WithRequired(true),
+		NewSubAttachment(" ", 0),
+		NewSubAttachment("Proof of address", 1),
+	})
+
+	err := request.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	want := "attachments[1] name is empty\nattachments[2] signer_index 1 is out of range for 1 signers"
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestSendSignatureRequest_ValidateMergeFields(t *testing.T) {
+	template := &TemplateResponse{
+		TemplateID: "tmpl-1",
+		CustomFields: []TemplateResponseCustomField{
+			{Name: "first_name", Type: "text"},
+			{Name: "Start Date", Type: "date"},
+		},
+	}
+
+	request := newValidRequest().
+		WithSubject("{First_Name}, your contract is ready").
+		WithMessage("Hi {frist_name}, you start on { start date }. Reply to {manager}.")
+
+	err := request.ValidateMergeFields(template)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	want := "message placeholder {frist_name} is not a custom field of the template\nmessage placeholder {manager} is not a custom field of the template"
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+
+	request.WithMessage("Hi {first_name}")
+	if err := request.ValidateMergeFields(template); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package dropboxsign
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// WarningName is the machine-readable identifier of a warning returned by the API.
+type WarningName string
+
+const (
+	// WarningNameCCMissing indicates a CC role defined by the template was not assigned
+	WarningNameCCMissing WarningName = "cc_missing"
+	// WarningNameCustomFieldValueExceedsLength indicates a custom field value
+	// was longer than its field and was truncated
+	WarningNameCustomFieldValueExceedsLength WarningName = "custom_field_value_exceeds_length"
+	// WarningNamePartialFieldMatch indicates only some of the given custom
+	// fields matched fields in the document
+	WarningNamePartialFieldMatch WarningName = "partial_field_match"
+	// WarningNameIncludeTextTags indicates the document contains text tags
+	// that were not converted into fields
+	WarningNameIncludeTextTags WarningName = "include_text_tags"
+	// WarningNameMalformedWarnings is added by the client, in place of the
+	// API's warnings, when the warnings in a response cannot be decoded
+	WarningNameMalformedWarnings WarningName = "malformed_warnings"
+	// WarningNameUnknown indicates a warning name not known to this package
+	WarningNameUnknown WarningName = "unknown"
+)
+
+// ParseWarningName parses a string into a WarningName.
+//
+// Unrecognized values return WarningNameUnknown.
+func ParseWarningName(s string) WarningName {
+	switch strings.TrimSpace(strings.ToLower(s)) {
+	case "cc_missing":
+		return WarningNameCCMissing
+	case "custom_field_value_exceeds_length":
+		return WarningNameCustomFieldValueExceedsLength
+	case "partial_field_match":
+		return WarningNamePartialFieldMatch
+	case "include_text_tags":
+		return WarningNameIncludeTextTags
+	case "malformed_warnings":
+		return WarningNameMalformedWarnings
+	default:
+		return WarningNameUnknown
+	}
+}
+
+// Name returns the typed name of the warning.
+//
+// Match on Name rather than on WarningMsg, which is meant for people and may
+// change wording.
+//
+// Example:
+//
+//	for _, warning := range warnings {
+//		if warning.Name() == dropboxsign.WarningNameCustomFieldValueExceedsLength {
+//			log.Printf("truncated: %s", warning.WarningMsg)
+//		}
+//	}
+func (w WarningResponse) Name() WarningName {
+	return ParseWarningName(w.WarningName)
+}
+
+// decodeWarnings decodes the warnings of a response.
+//
+// Warnings that cannot be decoded, such as after a change to their schema,
+// do not fail the request. They are replaced by a single warning named
+// WarningNameMalformedWarnings, so the problem stays visible to callers and
+// can be made fatal with WithStrictWarnings.
+func decodeWarnings(m Marshaler, data json.RawMessage) []WarningResponse {
+	if data == nil {
+		return nil
+	}
+	var warnings []WarningResponse
+	if err := m.Unmarshal(data, &warnings); err != nil {
+		return []WarningResponse{{
+			WarningMsg:  fmt.Sprintf("the warnings in the response could not be decoded: %v", err),
+			WarningName: string(WarningNameMalformedWarnings),
+		}}
+	}
+	return warnings
+}
+
+// warningsError returns the error decoding the warnings of a response body,
+// or nil if the body has no warnings or they decode.
+func warningsError(m Marshaler, body []byte) error {
+	var rawResponse map[string]json.RawMessage
+	if err := m.Unmarshal(body, &rawResponse); err != nil {
+		return nil
+	}
+	data, ok := rawResponse["warnings"]
+	if !ok {
+		return nil
+	}
+	var warnings []WarningResponse
+	if err := m.Unmarshal(data, &warnings); err != nil {
+		return fmt.Errorf("failed to decode warnings: %w", err)
+	}
+	return nil
+}
+
+// StrictWarningError is returned when a signature request is sent but the API
+// responds with a warning the client treats as an error (see
+// WithStrictWarnings).
+//
+// The signature request has already been created; cancel it if it must not
+// go ahead.
+type StrictWarningError struct {
+	// Warnings are the warnings that made the send fail
+	Warnings []WarningResponse
+	// SignatureRequest is the signature request that was created
+	SignatureRequest *SignatureRequestResponse
+}
+
+// Error implements the error interface for StrictWarningError.
+func (e *StrictWarningError) Error() string {
+	names := make([]string, len(e.Warnings))
+	for i, warning := range e.Warnings {
+		names[i] = warning.WarningName
+	}
+	return fmt.Sprintf("signature request %s was sent with warnings: %s", e.SignatureRequest.SignatureRequestID, strings.Join(names, ", "))
+}
+
+// IsStrictWarning returns true if the error is, or wraps, a StrictWarningError.
+func IsStrictWarning(err error) bool {
+	var warningErr *StrictWarningError
+	return errors.As(err, &warningErr)
+}
+
+// WithStrictWarnings makes sends fail with a *StrictWarningError when the API
+// responds with any of the given warnings, instead of succeeding with them.
+//
+// It applies to the methods that send a signature request, such as
+// SendWithTemplate and CreateEmbeddedWithTemplate. Since the warnings arrive
+// with the response, the signature request has already been created when the
+// error is returned; the error carries it so it can be canceled. Call with no
+// names to turn strict mode off.
+//
+// Returns the client instance for method chaining.
+//
+// Example:
+//
+//	client := dropboxsign.NewClient(apiKey).
+//		WithStrictWarnings(dropboxsign.WarningNameCustomFieldValueExceedsLength)
+//
+//	_, _, err := client.SendWithTemplate(ctx, request)
+//	var warningErr *dropboxsign.StrictWarningError
+//	if errors.As(err, &warningErr) {
+//		client.CancelIncompleteSignatureRequest(ctx, warningErr.SignatureRequest.SignatureRequestID)
+//	}
+func (c *Client) WithStrictWarnings(names ...WarningName) *Client {
+	c.strictWarnings = append([]WarningName(nil), names...)
+	return c
+}
+
+// checkStrictWarnings returns a *StrictWarningError if any of warnings is
+// one of the client's strict warnings.
+func (c *Client) checkStrictWarnings(sigRequest *SignatureRequestResponse, warnings []WarningResponse) error {
+	var matched []WarningResponse
+	for _, warning := range warnings {
+		name := warning.Name()
+		for _, strict := range c.strictWarnings {
+			if name == strict {
+				matched = append(matched, warning)
+				break
+			}
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+	return &StrictWarningError{Warnings: matched, SignatureRequest: sigRequest}
+}
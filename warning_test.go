@@ -0,0 +1,123 @@
+package dropboxsign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWarningResponse_Name(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected WarningName
+	}{
+		{"cc_missing", WarningNameCCMissing},
+		{"custom_field_value_exceeds_length", WarningNameCustomFieldValueExceedsLength},
+		{"partial_field_match", WarningNamePartialFieldMatch},
+		{"Include_Text_Tags", WarningNameIncludeTextTags},
+		{"malformed_warnings", WarningNameMalformedWarnings},
+		{"something_new", WarningNameUnknown},
+		{"", WarningNameUnknown},
+	}
+
+	for _, tt := range tests {
+		warning := WarningResponse{WarningName: tt.input}
+		if got := warning.Name(); got != tt.expected {
+			t.Errorf("Name() for %q: expected %s, got %s", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestWithStrictWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}, "warnings": [{"warning_msg": "Value was truncated", "warning_name": "custom_field_value_exceeds_length"}, {"warning_msg": "Missing CC", "warning_name": "cc_missing"}]}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		strict       []WarningName
+		wantErr      bool
+		wantWarnings []string
+	}{
+		{name: "not strict", strict: nil},
+		{name: "unlisted warning", strict: []WarningName{WarningNamePartialFieldMatch}},
+		{name: "listed warning", strict: []WarningName{WarningNameCustomFieldValueExceedsLength}, wantErr: true, wantWarnings: []string{"custom_field_value_exceeds_length"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithStrictWarnings(tt.strict...)
+
+			sigRequest, warnings, err := client.SendWithTemplate(context.Background(), newValidRequest())
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if sigRequest == nil || len(warnings) != 2 {
+					t.Errorf("expected success with 2 warnings, got %v", warnings)
+				}
+				return
+			}
+
+			if !IsStrictWarning(err) {
+				t.Fatalf("expected StrictWarningError, got %v", err)
+			}
+			warningErr := err.(*StrictWarningError)
+			if warningErr.SignatureRequest.SignatureRequestID != "abc123" {
+				t.Errorf("expected created signature request abc123, got %s", warningErr.SignatureRequest.SignatureRequestID)
+			}
+			if len(warningErr.Warnings) != len(tt.wantWarnings) || warningErr.Warnings[0].WarningName != tt.wantWarnings[0] {
+				t.Errorf("expected warnings %v, got %v", tt.wantWarnings, warningErr.Warnings)
+			}
+		})
+	}
+}
+
+func TestMalformedWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"signature_request": {"signature_request_id": "abc123"}, "warnings": {"warning_msg": "Missing CC", "warning_name": "cc_missing"}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client := NewClient("test-api-key").WithBaseURL(server.URL + "/v3").WithLogger(logger)
+
+	sigRequest, warnings, err := client.GetSignatureRequest(context.Background(), "abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sigRequest.SignatureRequestID != "abc123" {
+		t.Errorf("expected abc123, got %s", sigRequest.SignatureRequestID)
+	}
+	if len(warnings) != 1 || warnings[0].Name() != WarningNameMalformedWarnings {
+		t.Errorf("expected a malformed warnings warning, got %v", warnings)
+	}
+	if len(logger.infos) != 1 || logger.infos[0].WarningsErr == nil {
+		t.Errorf("expected the malformed warnings to be logged, got %+v", logger.infos)
+	}
+
+	client.WithStrictWarnings(WarningNameMalformedWarnings)
+	if _, _, err := client.SendWithTemplate(context.Background(), newValidRequest()); !IsStrictWarning(err) {
+		t.Errorf("expected StrictWarningError, got %v", err)
+	}
+}
+
+func TestIsStrictWarning_Wrapped(t *testing.T) {
+	err := fmt.Errorf("send contract: %w", &StrictWarningError{SignatureRequest: &SignatureRequestResponse{SignatureRequestID: "abc123"}})
+	if !IsStrictWarning(err) {
+		t.Errorf("expected a wrapped StrictWarningError to be recognized, got %v", err)
+	}
+	if IsStrictWarning(errors.New("boom")) {
+		t.Error("expected other errors not to be recognized")
+	}
+}